@@ -153,7 +153,7 @@ func (a *AquariumEffect) init() {
 	seaweedCount := a.width / 8
 	for i := 0; i < seaweedCount; i++ {
 		x := a.rng.Intn(a.width)
-		height := 3 + a.rng.Intn(a.height/3)
+		height := 3 + a.rng.Intn(max(a.height/3, 1))
 		variant := a.rng.Intn(2) // 0=straight, 1=wavy
 
 		a.seaweed = append(a.seaweed, Seaweed{
@@ -276,7 +276,7 @@ func (a *AquariumEffect) spawnFish() {
 
 	fish := Fish{
 		x:         x,
-		y:         float64(minY + a.rng.Intn(maxY-minY)),
+		y:         float64(a.randomY(minY, maxY)),
 		speed:     speed,
 		size:      size,
 		direction: direction,
@@ -311,7 +311,7 @@ func (a *AquariumEffect) spawnMediumFish() {
 
 	fish := Fish{
 		x:         x,
-		y:         float64(minY + a.rng.Intn(maxY-minY)),
+		y:         float64(a.randomY(minY, maxY)),
 		speed:     speed,
 		size:      2, // Medium
 		direction: direction,
@@ -346,7 +346,7 @@ func (a *AquariumEffect) spawnLargeFish() {
 
 	fish := Fish{
 		x:         x,
-		y:         float64(minY + a.rng.Intn(maxY-minY)),
+		y:         float64(a.randomY(minY, maxY)),
 		speed:     speed,
 		size:      3, // Large
 		direction: direction,
@@ -556,6 +556,15 @@ func (a *AquariumEffect) getMermaidPattern() []string {
 	}
 }
 
+// randomY returns a random row in [minY, maxY), or minY when the screen is too
+// short for the range
+func (a *AquariumEffect) randomY(minY, maxY int) int {
+	if maxY <= minY {
+		return minY
+	}
+	return minY + a.rng.Intn(maxY-minY)
+}
+
 // spawnBubble creates a new bubble
 func (a *AquariumEffect) spawnBubble() {
 	oceanY := int(float64(a.height) * 0.15)
@@ -564,7 +573,7 @@ func (a *AquariumEffect) spawnBubble() {
 
 	a.bubbles = append(a.bubbles, Bubble{
 		x:         float64(a.rng.Intn(a.width)),
-		y:         float64(minY + a.rng.Intn(maxY-minY)),
+		y:         float64(a.randomY(minY, maxY)),
 		speed:     0.2 + a.rng.Float64()*0.3,
 		wobble:    a.rng.Float64() * math.Pi * 2,
 		wobbleAmt: 0.3 + a.rng.Float64()*0.3,
@@ -2,56 +2,93 @@ package animations
 
 import (
 	"fmt"
-	"math"
 	"math/rand"
 	"sort"
 	"strings"
 	"time"
 
+	"github.com/Nomadcxx/sysc-Go/animations/motion"
 	"github.com/charmbracelet/lipgloss/v2"
 )
 
-// BeamsEffect implements beams that travel across rows and columns, illuminating text
+// BeamsEffect implements beams that travel across rows, columns and diagonals, illuminating text
 type BeamsEffect struct {
 	width  int
 	height int
 	text   string
 
 	// Configuration
-	beamRowSymbols      []rune
-	beamColumnSymbols   []rune
-	beamDelay           int
-	beamRowSpeedRange   [2]int
-	beamColumnSpeedRange [2]int
-	beamGradientStops   []string
-	beamGradientSteps   int
-	beamGradientFrames  int
-	finalGradientStops  []string
-	finalGradientSteps  int
-	finalGradientFrames int
-	finalWipeSpeed      int
+	beamRowSymbols         []rune
+	beamColumnSymbols      []rune
+	beamDiagonalSymbols    []rune
+	beamDirections         []string
+	beamDelay              int
+	beamRowSpeedRange      [2]int
+	beamColumnSpeedRange   [2]int
+	beamDiagonalSpeedRange [2]int
+	backgroundDensity      float64
+	beamGradientStops      []string
+	beamGradientSteps      int
+	beamGradientFrames     int
+	finalGradientStops     []string
+	finalGradientSteps     int
+	finalGradientFrames    int
+	finalWipeSpeed         int
+	loop                   string
 
 	// Character data
 	chars []BeamCharacter
 
 	// Beam groups
-	rowGroups    []BeamGroup
-	columnGroups []BeamGroup
+	rowGroups     []BeamGroup
+	columnGroups  []BeamGroup
+	diagonalBeams []BeamGroup
 
 	// Final wipe diagonal groups
 	diagonalGroups [][]int
 
 	// Animation state
-	phase          string // "beams", "final_wipe", or "hold"
+	phase          BeamsPhase
+	onPhaseChange  func(BeamsPhase)
+	onComplete     func()
 	frameCount     int
 	beamDelayCount int
 	currentDiag    int
-	holdFrames     int // Frames to hold after completion
-	holdCounter    int // Current hold frame count
+	holdFrames     int  // Frames to hold after completion
+	holdCounter    int  // Current hold frame count
+	reversing      bool // Playing back toward the start in the reverse loop
 
 	rng *rand.Rand
 }
 
+// BeamsPhase is a stage of the beams animation
+type BeamsPhase int
+
+const (
+	BeamsPhaseBeams     BeamsPhase = iota // Beams sweep across rows and columns
+	BeamsPhaseFinalWipe                   // A diagonal wipe brightens the text to its final colors
+	BeamsPhaseHold                        // The finished text holds before the animation loops
+)
+
+// BeamsDirections lists the directions beams can travel in
+var BeamsDirections = []string{"row", "column", "diagonal", "anti-diagonal"}
+
+// BeamsLoopModes lists what the beams do once the hold ends
+var BeamsLoopModes = []string{"restart", "reverse", "stop"}
+
+// String returns the name of the phase
+func (p BeamsPhase) String() string {
+	switch p {
+	case BeamsPhaseBeams:
+		return "beams"
+	case BeamsPhaseFinalWipe:
+		return "final_wipe"
+	case BeamsPhaseHold:
+		return "hold"
+	}
+	return fmt.Sprintf("BeamsPhase(%d)", int(p))
+}
+
 // BeamCharacter represents a single character in the beams animation
 type BeamCharacter struct {
 	original rune
@@ -59,20 +96,20 @@ type BeamCharacter struct {
 	y        int
 
 	// Animation state
-	visible         bool
-	currentSymbol   rune
-	currentColor    string
-	sceneActive     string // "beam_row", "beam_column", or "brighten"
-	sceneFrame      int
-	beamGradient    []string
-	fadeGradient    []string
+	visible          bool
+	currentSymbol    rune
+	currentColor     string
+	sceneActive      string // "beam_row", "beam_column", "beam_diagonal", "fade", "brighten", "dim" or "unbeam"
+	sceneFrame       int
+	beamGradient     []string
+	fadeGradient     []string
 	brightenGradient []string
 }
 
 // BeamGroup represents a group of characters for beam animation
 type BeamGroup struct {
 	charIndices        []int
-	direction          string  // "row" or "column"
+	direction          string // "row", "column", "diagonal" or "anti-diagonal"
 	speed              float64
 	nextCharCounter    float64
 	currentCharIndex   int
@@ -100,6 +137,39 @@ type BeamsConfig struct {
 	FinalGradientSteps   int
 	FinalGradientFrames  int
 	FinalWipeSpeed       int
+
+	// BeamDirections picks the beam groups from BeamsDirections, default
+	// "row" and "column". Diagonal beams run down-right, anti-diagonal
+	// beams down-left, both drawn with BeamDiagonalSymbols (default ▓▒░)
+	// at BeamDiagonalSpeedRange.
+	BeamDirections         []string
+	BeamDiagonalSymbols    []rune
+	BeamDiagonalSpeedRange [2]int
+
+	// BackgroundDensity is the share of screen cells the beams light up
+	// without text, from 0 to 1 (default 1). Speed ranges, BeamDelay and
+	// BeamGradientSteps apply in both modes; left at zero they default to
+	// faster, denser beams without text.
+	BackgroundDensity float64
+
+	// HoldDuration is how long the final frame holds, default 5s with text
+	// and a single frame without. Loop is what happens next, one of
+	// BeamsLoopModes: "restart" (default) plays the beams again, "reverse"
+	// first plays the final wipe and the beams backwards to an empty screen,
+	// and "stop" stays on the final frame.
+	HoldDuration time.Duration
+	Loop         string
+
+	// FrameDuration is the time one Update stands for, default 50ms. Set it
+	// to the delay between frames so HoldDuration plays out in real time.
+	FrameDuration time.Duration
+
+	// OnPhaseChange is called with the new phase whenever it changes, and
+	// OnComplete each time the text is complete and starts to hold. Both are
+	// optional and run inside Update. Without text the beams only complete
+	// when Loop is "stop".
+	OnPhaseChange func(BeamsPhase)
+	OnComplete    func()
 }
 
 // NewBeamsEffect creates a new beams effect with given configuration
@@ -113,17 +183,44 @@ func NewBeamsEffect(config BeamsConfig) *BeamsEffect {
 	if len(config.BeamColumnSymbols) == 0 {
 		config.BeamColumnSymbols = []rune{'▌', '▍', '▎', '▏'}
 	}
+	if len(config.BeamDiagonalSymbols) == 0 {
+		config.BeamDiagonalSymbols = []rune{'▓', '▒', '░'}
+	}
+	if len(config.BeamDirections) == 0 {
+		config.BeamDirections = []string{"row", "column"}
+	}
+
+	// Background mode defaults to much faster, denser beams
+	background := config.Text == ""
 	if config.BeamDelay == 0 {
 		config.BeamDelay = 2 // Faster group activation
+		if background {
+			config.BeamDelay = 1
+		}
 	}
 	if config.BeamRowSpeedRange[0] == 0 {
 		config.BeamRowSpeedRange = [2]int{20, 80} // Much faster speeds
+		if background {
+			config.BeamRowSpeedRange = [2]int{40, 120}
+		}
 	}
 	if config.BeamColumnSpeedRange[0] == 0 {
 		config.BeamColumnSpeedRange = [2]int{15, 30} // Much faster speeds
+		if background {
+			config.BeamColumnSpeedRange = [2]int{30, 60}
+		}
+	}
+	if config.BeamDiagonalSpeedRange[0] == 0 {
+		config.BeamDiagonalSpeedRange = [2]int{15, 45}
+		if background {
+			config.BeamDiagonalSpeedRange = [2]int{30, 90}
+		}
 	}
 	if config.BeamGradientSteps == 0 {
 		config.BeamGradientSteps = 5 // Shorter gradient
+		if background {
+			config.BeamGradientSteps = 3
+		}
 	}
 	if config.BeamGradientFrames == 0 {
 		config.BeamGradientFrames = 1
@@ -137,44 +234,52 @@ func NewBeamsEffect(config BeamsConfig) *BeamsEffect {
 	if config.FinalWipeSpeed == 0 {
 		config.FinalWipeSpeed = 3 // Activate multiple diagonal groups per frame
 	}
-
-	// Adjust speeds for background mode
-	rowSpeedRange := config.BeamRowSpeedRange
-	colSpeedRange := config.BeamColumnSpeedRange
-	beamDelay := config.BeamDelay
-	gradientSteps := config.BeamGradientSteps
-
-	if config.Text == "" {
-		// Background mode: much faster, denser beams
-		rowSpeedRange = [2]int{40, 120}
-		colSpeedRange = [2]int{30, 60}
-		beamDelay = 1
-		gradientSteps = 3
+	if config.BackgroundDensity <= 0 || config.BackgroundDensity > 1 {
+		config.BackgroundDensity = 1
+	}
+	if config.Loop == "" {
+		config.Loop = "restart"
+	}
+	if config.FrameDuration <= 0 {
+		config.FrameDuration = 50 * time.Millisecond
+	}
+	if config.HoldDuration <= 0 {
+		config.HoldDuration = 5 * time.Second
+		if background {
+			config.HoldDuration = config.FrameDuration // Loop right away
+		}
 	}
 
 	b := &BeamsEffect{
-		width:                config.Width,
-		height:               config.Height,
-		text:                 config.Text,
-		beamRowSymbols:       config.BeamRowSymbols,
-		beamColumnSymbols:    config.BeamColumnSymbols,
-		beamDelay:            beamDelay,
-		beamRowSpeedRange:    rowSpeedRange,
-		beamColumnSpeedRange: colSpeedRange,
-		beamGradientStops:    config.BeamGradientStops,
-		beamGradientSteps:    gradientSteps,
-		beamGradientFrames:   config.BeamGradientFrames,
-		finalGradientStops:   config.FinalGradientStops,
-		finalGradientSteps:   config.FinalGradientSteps,
-		finalGradientFrames:  config.FinalGradientFrames,
-		finalWipeSpeed:       config.FinalWipeSpeed,
-		phase:                "beams",
-		frameCount:           0,
-		beamDelayCount:       0,
-		currentDiag:          0,
-		holdFrames:           100, // 5 seconds at 20fps
-		holdCounter:          0,
-		rng:                  rng,
+		width:                  config.Width,
+		height:                 config.Height,
+		text:                   config.Text,
+		beamRowSymbols:         config.BeamRowSymbols,
+		beamColumnSymbols:      config.BeamColumnSymbols,
+		beamDiagonalSymbols:    config.BeamDiagonalSymbols,
+		beamDirections:         config.BeamDirections,
+		beamDelay:              config.BeamDelay,
+		beamRowSpeedRange:      config.BeamRowSpeedRange,
+		beamColumnSpeedRange:   config.BeamColumnSpeedRange,
+		beamDiagonalSpeedRange: config.BeamDiagonalSpeedRange,
+		backgroundDensity:      config.BackgroundDensity,
+		beamGradientStops:      config.BeamGradientStops,
+		beamGradientSteps:      config.BeamGradientSteps,
+		beamGradientFrames:     config.BeamGradientFrames,
+		finalGradientStops:     config.FinalGradientStops,
+		finalGradientSteps:     config.FinalGradientSteps,
+		finalGradientFrames:    config.FinalGradientFrames,
+		finalWipeSpeed:         config.FinalWipeSpeed,
+		loop:                   config.Loop,
+		phase:                  BeamsPhaseBeams,
+		onPhaseChange:          config.OnPhaseChange,
+		onComplete:             config.OnComplete,
+		frameCount:             0,
+		beamDelayCount:         0,
+		currentDiag:            0,
+		holdFrames:             max(int(config.HoldDuration/config.FrameDuration), 1),
+		holdCounter:            0,
+		rng:                    rng,
 	}
 
 	b.init()
@@ -191,11 +296,17 @@ func (b *BeamsEffect) init() {
 		b.initTextMode()
 	}
 
-	// Create row groups
-	b.createRowGroups()
-
-	// Create column groups
-	b.createColumnGroups()
+	// Create beam groups for each enabled direction
+	for _, direction := range b.beamDirections {
+		switch direction {
+		case "row":
+			b.createRowGroups()
+		case "column":
+			b.createColumnGroups()
+		case "diagonal", "anti-diagonal":
+			b.createDiagonalBeamGroups(direction)
+		}
+	}
 
 	// Shuffle groups for random activation
 	b.shuffleGroups()
@@ -273,10 +384,13 @@ func (b *BeamsEffect) initBackgroundMode() {
 	beamGradient := b.createGradient(b.beamGradientStops, b.beamGradientSteps)
 	fadeGradient := b.createFadeGradient(beamGradient[len(beamGradient)-1], 3)
 
-	// Fill terminal with dense distribution for glowing effect
-	// Every position for maximum density
+	// Fill terminal with positions for the glowing effect, skipping cells
+	// at random below full density
 	for y := 0; y < b.height; y++ {
 		for x := 0; x < b.width; x++ {
+			if b.backgroundDensity < 1 && b.rng.Float64() >= b.backgroundDensity {
+				continue
+			}
 			b.chars = append(b.chars, BeamCharacter{
 				original:         ' ',
 				x:                x,
@@ -317,7 +431,7 @@ func (b *BeamsEffect) createRowGroups() {
 			}
 		}
 
-		speed := float64(b.rng.Intn(b.beamRowSpeedRange[1]-b.beamRowSpeedRange[0])+b.beamRowSpeedRange[0]) * 0.1
+		speed := b.randomSpeed(b.beamRowSpeedRange)
 
 		b.rowGroups = append(b.rowGroups, BeamGroup{
 			charIndices:        indices,
@@ -357,7 +471,7 @@ func (b *BeamsEffect) createColumnGroups() {
 			}
 		}
 
-		speed := float64(b.rng.Intn(b.beamColumnSpeedRange[1]-b.beamColumnSpeedRange[0])+b.beamColumnSpeedRange[0]) * 0.1
+		speed := b.randomSpeed(b.beamColumnSpeedRange)
 
 		b.columnGroups = append(b.columnGroups, BeamGroup{
 			charIndices:        indices,
@@ -374,10 +488,69 @@ func (b *BeamsEffect) createColumnGroups() {
 	}
 }
 
-// shuffleGroups shuffles row and column groups together
+// createDiagonalBeamGroups creates beam groups for each diagonal line, running
+// down-right for "diagonal" and down-left for "anti-diagonal"
+func (b *BeamsEffect) createDiagonalBeamGroups(direction string) {
+	// Group characters by diagonal
+	diagMap := make(map[int][]int)
+	for i, char := range b.chars {
+		key := char.x - char.y
+		if direction == "anti-diagonal" {
+			key = char.x + char.y
+		}
+		diagMap[key] = append(diagMap[key], i)
+	}
+
+	// Create groups
+	for _, indices := range diagMap {
+		// Sort by y coordinate
+		sort.Slice(indices, func(i, j int) bool {
+			return b.chars[indices[i]].y < b.chars[indices[j]].y
+		})
+
+		// Randomly reverse
+		if b.rng.Float64() < 0.5 {
+			for i := 0; i < len(indices)/2; i++ {
+				j := len(indices) - 1 - i
+				indices[i], indices[j] = indices[j], indices[i]
+			}
+		}
+
+		b.diagonalBeams = append(b.diagonalBeams, BeamGroup{
+			charIndices:        indices,
+			direction:          direction,
+			speed:              b.randomSpeed(b.beamDiagonalSpeedRange),
+			nextCharCounter:    0,
+			currentCharIndex:   0,
+			symbols:            b.beamDiagonalSymbols,
+			beamGradientStops:  b.beamGradientStops,
+			beamGradientSteps:  b.beamGradientSteps,
+			beamGradientFrames: b.beamGradientFrames,
+			beamLength:         len(b.beamDiagonalSymbols),
+		})
+	}
+}
+
+// randomSpeed picks a group speed in characters per frame from a range
+// given in tenths
+func (b *BeamsEffect) randomSpeed(speedRange [2]int) float64 {
+	speed := speedRange[0]
+	if speedRange[1] > speedRange[0] {
+		speed += b.rng.Intn(speedRange[1] - speedRange[0])
+	}
+	return float64(speed) * 0.1
+}
+
+// beamGroups returns the row, column and diagonal beam groups. The slices
+// share storage with the effect, so groups can be updated through them.
+func (b *BeamsEffect) beamGroups() [][]BeamGroup {
+	return [][]BeamGroup{b.rowGroups, b.columnGroups, b.diagonalBeams}
+}
+
+// shuffleGroups shuffles row, column and diagonal groups together
 func (b *BeamsEffect) shuffleGroups() {
-	// Combine both types of groups
-	allGroups := append(b.rowGroups, b.columnGroups...)
+	// Combine all types of groups
+	allGroups := append(append(b.rowGroups, b.columnGroups...), b.diagonalBeams...)
 
 	// Fisher-Yates shuffle
 	for i := len(allGroups) - 1; i > 0; i-- {
@@ -386,14 +559,18 @@ func (b *BeamsEffect) shuffleGroups() {
 	}
 
 	// Split back
-	b.rowGroups = b.rowGroups[:0]
-	b.columnGroups = b.columnGroups[:0]
+	b.rowGroups = nil
+	b.columnGroups = nil
+	b.diagonalBeams = nil
 
 	for _, group := range allGroups {
-		if group.direction == "row" {
+		switch group.direction {
+		case "row":
 			b.rowGroups = append(b.rowGroups, group)
-		} else {
+		case "column":
 			b.columnGroups = append(b.columnGroups, group)
+		default:
+			b.diagonalBeams = append(b.diagonalBeams, group)
 		}
 	}
 }
@@ -419,7 +596,7 @@ func (b *BeamsEffect) createDiagonalGroups() {
 	}
 }
 
-// createGradient creates a color gradient from stops
+// createGradient creates a color gradient from stops, steps+1 colors long
 func (b *BeamsEffect) createGradient(stops []string, steps int) []string {
 	if len(stops) == 0 {
 		return []string{"#ffffff"}
@@ -427,59 +604,24 @@ func (b *BeamsEffect) createGradient(stops []string, steps int) []string {
 	if len(stops) == 1 {
 		return []string{stops[0]}
 	}
-
-	var gradient []string
-	stepsPerSegment := steps / (len(stops) - 1)
-
-	for i := 0; i < len(stops)-1; i++ {
-		c1 := parseHexColor(stops[i])
-		c2 := parseHexColor(stops[i+1])
-
-		for j := 0; j < stepsPerSegment; j++ {
-			t := float64(j) / float64(stepsPerSegment)
-			r := uint8(float64(c1[0])*(1-t) + float64(c2[0])*t)
-			g := uint8(float64(c1[1])*(1-t) + float64(c2[1])*t)
-			b := uint8(float64(c1[2])*(1-t) + float64(c2[2])*t)
-			gradient = append(gradient, formatHexColor([3]uint8{r, g, b}))
-		}
-	}
-
-	// Add final color
-	gradient = append(gradient, stops[len(stops)-1])
-
-	return gradient
+	return motion.NewGradient(stops...).Steps(steps + 1)
 }
 
 // createFadeGradient creates a fade to dark gradient
 func (b *BeamsEffect) createFadeGradient(startColor string, steps int) []string {
-	rgb := parseHexColor(startColor)
-	targetRGB := [3]uint8{
-		uint8(float64(rgb[0]) * 0.3),
-		uint8(float64(rgb[1]) * 0.3),
-		uint8(float64(rgb[2]) * 0.3),
-	}
-
-	var gradient []string
-	for i := 0; i <= steps; i++ {
-		t := float64(i) / float64(steps)
-		r := uint8(float64(rgb[0])*(1-t) + float64(targetRGB[0])*t)
-		g := uint8(float64(rgb[1])*(1-t) + float64(targetRGB[1])*t)
-		b := uint8(float64(rgb[2])*(1-t) + float64(targetRGB[2])*t)
-		gradient = append(gradient, formatHexColor([3]uint8{r, g, b}))
-	}
-
-	return gradient
+	return motion.NewGradient(startColor, motion.Scale(startColor, 0.3)).Steps(steps + 1)
 }
 
 // Update advances the beams animation by one frame
 func (b *BeamsEffect) Update() {
 	b.frameCount++
 
-	if b.phase == "beams" {
+	switch b.phase {
+	case BeamsPhaseBeams:
 		b.updateBeamsPhase()
-	} else if b.phase == "final_wipe" {
+	case BeamsPhaseFinalWipe:
 		b.updateFinalWipePhase()
-	} else if b.phase == "hold" {
+	case BeamsPhaseHold:
 		b.updateHoldPhase()
 	}
 
@@ -495,26 +637,18 @@ func (b *BeamsEffect) updateBeamsPhase() {
 		return
 	}
 
-	// Activate next group(s)
+	// Activate next group(s), one of each direction at a time
 	groupsToActivate := b.rng.Intn(5) + 1
 	activated := false
 
 	for i := 0; i < groupsToActivate; i++ {
-		// Try to activate a row group
-		for j := range b.rowGroups {
-			if b.rowGroups[j].currentCharIndex == 0 && b.rowGroups[j].nextCharCounter == 0 {
-				b.rowGroups[j].nextCharCounter = 0.01 // Start the group
-				activated = true
-				break
-			}
-		}
-
-		// Try to activate a column group
-		for j := range b.columnGroups {
-			if b.columnGroups[j].currentCharIndex == 0 && b.columnGroups[j].nextCharCounter == 0 {
-				b.columnGroups[j].nextCharCounter = 0.01
-				activated = true
-				break
+		for _, groups := range b.beamGroups() {
+			for j := range groups {
+				if b.groupIdle(&groups[j]) {
+					groups[j].nextCharCounter = 0.01 // Start the group
+					activated = true
+					break
+				}
 			}
 		}
 	}
@@ -526,22 +660,41 @@ func (b *BeamsEffect) updateBeamsPhase() {
 	// Update all active groups
 	allGroupsComplete := true
 
-	for i := range b.rowGroups {
-		if b.updateGroup(&b.rowGroups[i]) {
-			allGroupsComplete = false
+	for _, groups := range b.beamGroups() {
+		for i := range groups {
+			if b.updateGroup(&groups[i]) {
+				allGroupsComplete = false
+			}
 		}
 	}
 
-	for i := range b.columnGroups {
-		if b.updateGroup(&b.columnGroups[i]) {
-			allGroupsComplete = false
+	if !allGroupsComplete {
+		return
+	}
+
+	if b.reversing {
+		// Played back to the start once every character has gone dark
+		for i := range b.chars {
+			if b.chars[i].sceneActive == "unbeam" {
+				return
+			}
 		}
+		b.Reset()
+		return
 	}
+	b.setPhase(BeamsPhaseFinalWipe)
+}
 
-	// Check if all groups are complete
-	if allGroupsComplete {
-		b.phase = "final_wipe"
+// groupIdle reports whether a group is waiting to start. Forward groups
+// start from their first character, reversing groups from their last.
+func (b *BeamsEffect) groupIdle(group *BeamGroup) bool {
+	if group.nextCharCounter != 0 {
+		return false
+	}
+	if b.reversing {
+		return group.currentCharIndex == len(group.charIndices)
 	}
+	return group.currentCharIndex == 0
 }
 
 // updateGroup updates a single beam group and returns true if still active
@@ -550,6 +703,10 @@ func (b *BeamsEffect) updateGroup(group *BeamGroup) bool {
 		return false // Group not started
 	}
 
+	if b.reversing {
+		return b.reverseGroup(group)
+	}
+
 	if group.currentCharIndex >= len(group.charIndices) {
 		return false // Group complete
 	}
@@ -566,28 +723,26 @@ func (b *BeamsEffect) updateGroup(group *BeamGroup) bool {
 		char := &b.chars[charIdx]
 
 		// Activate beam scene
-		if group.direction == "row" {
+		switch group.direction {
+		case "row":
 			char.sceneActive = "beam_row"
-		} else {
+		case "column":
 			char.sceneActive = "beam_column"
+		default:
+			char.sceneActive = "beam_diagonal"
 		}
 		char.sceneFrame = 0
 		char.visible = true
 
-		// Use symbol based on position in beam for gradient effect
-		// Most recent chars get thickest symbol, trailing chars get thinner
-		symbolIndex := 0 // Default to thickest
-		if len(group.symbols) > 0 {
-			symbolIndex = 0 // Head of beam is always thickest
-		}
-		char.currentSymbol = group.symbols[symbolIndex]
+		// Head of beam is always thickest
+		char.currentSymbol = group.symbols[0]
 
 		// Update trailing characters to use progressively thinner symbols
 		for j := 1; j < group.beamLength && group.currentCharIndex-j >= 0; j++ {
 			trailCharIdx := group.charIndices[group.currentCharIndex-j]
 			trailChar := &b.chars[trailCharIdx]
 
-			if trailChar.sceneActive == "beam_row" || trailChar.sceneActive == "beam_column" {
+			if strings.HasPrefix(trailChar.sceneActive, "beam_") {
 				symbolIdx := j
 				if symbolIdx >= len(group.symbols) {
 					symbolIdx = len(group.symbols) - 1
@@ -602,15 +757,47 @@ func (b *BeamsEffect) updateGroup(group *BeamGroup) bool {
 	return true
 }
 
+// reverseGroup runs a beam group back from its last character, fading each
+// character back through its beam and out of sight. It returns true while
+// the group is still active.
+func (b *BeamsEffect) reverseGroup(group *BeamGroup) bool {
+	if group.currentCharIndex <= 0 {
+		return false // Group complete
+	}
+
+	group.nextCharCounter += group.speed
+
+	charsToActivate := int(group.nextCharCounter)
+	group.nextCharCounter -= float64(charsToActivate)
+
+	for i := 0; i < charsToActivate && group.currentCharIndex > 0; i++ {
+		group.currentCharIndex--
+		char := &b.chars[group.charIndices[group.currentCharIndex]]
+		if !char.visible {
+			continue
+		}
+		char.sceneActive = "unbeam"
+		char.sceneFrame = 0
+		char.currentSymbol = group.symbols[len(group.symbols)-1]
+	}
+
+	return true
+}
+
 // updateFinalWipePhase handles the final diagonal wipe
 func (b *BeamsEffect) updateFinalWipePhase() {
 	// In background mode, skip final wipe and go straight to hold
 	if b.text == "" {
-		b.phase = "hold"
+		b.setPhase(BeamsPhaseHold)
 		b.holdCounter = 0
 		return
 	}
 
+	if b.reversing {
+		b.reverseFinalWipe()
+		return
+	}
+
 	// Activate diagonal groups at specified speed
 	for i := 0; i < b.finalWipeSpeed && b.currentDiag < len(b.diagonalGroups); i++ {
 		for _, charIdx := range b.diagonalGroups[b.currentDiag] {
@@ -641,26 +828,69 @@ func (b *BeamsEffect) updateFinalWipePhase() {
 		}
 
 		if allComplete {
-			b.phase = "hold"
+			b.setPhase(BeamsPhaseHold)
 			b.holdCounter = 0
 		}
 	}
 }
 
+// reverseFinalWipe dims the text diagonal by diagonal from the far corner,
+// then hands over to the beams running backwards
+func (b *BeamsEffect) reverseFinalWipe() {
+	for i := 0; i < b.finalWipeSpeed && b.currentDiag > 0; i++ {
+		b.currentDiag--
+		for _, charIdx := range b.diagonalGroups[b.currentDiag] {
+			char := &b.chars[charIdx]
+			char.sceneActive = "dim"
+			char.sceneFrame = 0
+		}
+	}
+
+	if b.currentDiag > 0 {
+		return
+	}
+	for i := range b.chars {
+		if b.chars[i].sceneActive == "dim" {
+			return
+		}
+	}
+	b.startReverseBeams()
+}
+
+// startReverseBeams readies every beam group to run back from its end
+func (b *BeamsEffect) startReverseBeams() {
+	b.beamDelayCount = 0
+	for _, groups := range b.beamGroups() {
+		for i := range groups {
+			groups[i].nextCharCounter = 0
+			groups[i].currentCharIndex = len(groups[i].charIndices)
+		}
+	}
+	b.setPhase(BeamsPhaseBeams)
+}
+
 // updateHoldPhase handles the hold period after completion
 func (b *BeamsEffect) updateHoldPhase() {
-	b.holdCounter++
+	if b.loop == "stop" {
+		return
+	}
 
-	// In background mode, loop immediately without hold
-	holdDuration := b.holdFrames
-	if b.text == "" {
-		holdDuration = 0
+	b.holdCounter++
+	if b.holdCounter < b.holdFrames {
+		return
 	}
 
-	// After hold period, reset the animation
-	if b.holdCounter >= holdDuration {
-		b.Reset()
+	// After hold period, restart or play back to the start
+	if b.loop == "reverse" {
+		b.reversing = true
+		if b.text == "" {
+			b.startReverseBeams()
+		} else {
+			b.setPhase(BeamsPhaseFinalWipe)
+		}
+		return
 	}
+	b.Reset()
 }
 
 // updateCharacterAnimations updates all character animation scenes
@@ -673,7 +903,7 @@ func (b *BeamsEffect) updateCharacterAnimations() {
 		}
 
 		switch char.sceneActive {
-		case "beam_row", "beam_column":
+		case "beam_row", "beam_column", "beam_diagonal":
 			// Beam gradient phase
 			gradientLen := len(char.beamGradient)
 			if gradientLen == 0 {
@@ -732,6 +962,40 @@ func (b *BeamsEffect) updateCharacterAnimations() {
 				char.currentColor = char.brightenGradient[step]
 				char.sceneFrame++
 			}
+
+		case "dim":
+			// Brighten played backwards, back to the faded color
+			gradientLen := len(char.brightenGradient)
+			totalFrames := gradientLen * b.finalGradientFrames
+
+			if char.sceneFrame < totalFrames {
+				step := char.sceneFrame / b.finalGradientFrames
+				char.currentColor = char.brightenGradient[gradientLen-1-step]
+				char.sceneFrame++
+			} else {
+				char.sceneActive = ""
+				if len(char.fadeGradient) > 0 {
+					char.currentColor = char.fadeGradient[len(char.fadeGradient)-1]
+				}
+			}
+
+		case "unbeam":
+			// Fade and beam gradients played backwards, then hidden
+			fadeLen := len(char.fadeGradient)
+			beamFrames := len(char.beamGradient) * b.beamGradientFrames
+
+			switch {
+			case char.sceneFrame < fadeLen:
+				char.currentColor = char.fadeGradient[fadeLen-1-char.sceneFrame]
+			case char.sceneFrame < fadeLen+beamFrames:
+				step := (char.sceneFrame - fadeLen) / b.beamGradientFrames
+				char.currentColor = char.beamGradient[len(char.beamGradient)-1-step]
+			default:
+				char.sceneActive = ""
+				char.visible = false
+				char.currentSymbol = char.original
+			}
+			char.sceneFrame++
 		}
 	}
 }
@@ -785,11 +1049,12 @@ func (b *BeamsEffect) Render() string {
 
 // Reset restarts the animation from the beginning
 func (b *BeamsEffect) Reset() {
-	b.phase = "beams"
+	b.setPhase(BeamsPhaseBeams)
 	b.frameCount = 0
 	b.beamDelayCount = 0
 	b.currentDiag = 0
 	b.holdCounter = 0
+	b.reversing = false
 
 	// Reset all characters
 	for i := range b.chars {
@@ -801,31 +1066,12 @@ func (b *BeamsEffect) Reset() {
 	}
 
 	// Reset all groups
-	for i := range b.rowGroups {
-		b.rowGroups[i].nextCharCounter = 0
-		b.rowGroups[i].currentCharIndex = 0
-	}
-	for i := range b.columnGroups {
-		b.columnGroups[i].nextCharCounter = 0
-		b.columnGroups[i].currentCharIndex = 0
-	}
-}
-
-// parseHexColor converts hex color to RGB
-func parseHexColor(hex string) [3]uint8 {
-	hex = strings.TrimPrefix(hex, "#")
-	if len(hex) != 6 {
-		return [3]uint8{255, 255, 255}
+	for _, groups := range b.beamGroups() {
+		for i := range groups {
+			groups[i].nextCharCounter = 0
+			groups[i].currentCharIndex = 0
+		}
 	}
-
-	var r, g, b uint8
-	_, _ = fmt.Sscanf(hex, "%02x%02x%02x", &r, &g, &b)
-	return [3]uint8{r, g, b}
-}
-
-// formatHexColor converts RGB to hex color
-func formatHexColor(rgb [3]uint8) string {
-	return fmt.Sprintf("#%02x%02x%02x", rgb[0], rgb[1], rgb[2])
 }
 
 // Resize reinitializes the beams effect with new dimensions
@@ -835,15 +1081,66 @@ func (b *BeamsEffect) Resize(width, height int) {
 	b.chars = b.chars[:0]
 	b.rowGroups = b.rowGroups[:0]
 	b.columnGroups = b.columnGroups[:0]
+	b.diagonalBeams = b.diagonalBeams[:0]
 	b.diagonalGroups = b.diagonalGroups[:0]
 	b.init()
+	b.Reset()
+}
+
+// IsComplete returns whether the animation has reached its final frame.
+// In background mode (empty Text) the beams only complete when Loop is
+// "stop".
+func (b *BeamsEffect) IsComplete() bool {
+	return b.phase == BeamsPhaseHold && (b.text != "" || b.loop == "stop")
+}
+
+// setPhase moves to a new phase and calls the callbacks
+func (b *BeamsEffect) setPhase(phase BeamsPhase) {
+	if b.phase == phase {
+		return
+	}
+	b.phase = phase
+	if b.onPhaseChange != nil {
+		b.onPhaseChange(phase)
+	}
+	if b.IsComplete() && b.onComplete != nil {
+		b.onComplete()
+	}
+}
+
+// Phase returns the current phase of the animation
+func (b *BeamsEffect) Phase() BeamsPhase {
+	return b.phase
 }
 
-// Helper function to adjust brightness
-func adjustColorBrightness(color string, factor float64) string {
-	rgb := parseHexColor(color)
-	r := uint8(math.Min(255, float64(rgb[0])*factor))
-	g := uint8(math.Min(255, float64(rgb[1])*factor))
-	b := uint8(math.Min(255, float64(rgb[2])*factor))
-	return formatHexColor([3]uint8{r, g, b})
+// Progress returns how far the animation is from 0 to 1. With text the
+// beams take the first half and the final wipe the second; without text
+// the beams take it all. A reverse loop runs it back down to 0.
+func (b *BeamsEffect) Progress() float64 {
+	swept, total := 0, 0
+	for _, groups := range b.beamGroups() {
+		for _, group := range groups {
+			swept += group.currentCharIndex
+			total += len(group.charIndices)
+		}
+	}
+	beams := 1.0
+	if total > 0 {
+		beams = float64(swept) / float64(total)
+	}
+
+	switch b.phase {
+	case BeamsPhaseBeams:
+		if b.text == "" {
+			return beams
+		}
+		return beams / 2
+	case BeamsPhaseFinalWipe:
+		wipe := 1.0
+		if len(b.diagonalGroups) > 0 {
+			wipe = float64(b.currentDiag) / float64(len(b.diagonalGroups))
+		}
+		return 0.5 + wipe/2
+	}
+	return 1
 }
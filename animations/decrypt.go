@@ -2,11 +2,12 @@ package animations
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
-	"strconv"
 	"strings"
 	"time"
 
+	"github.com/Nomadcxx/sysc-Go/animations/motion"
 	"github.com/charmbracelet/lipgloss/v2"
 )
 
@@ -22,9 +23,50 @@ type DecryptEffect struct {
 	finalGradientStops     []string
 	finalGradientSteps     int
 	finalGradientDirection string
-	phase                  string
+	phase                  DecryptPhase
+	progress               float64 // Highest progress reached, so it never goes back
+	onPhaseChange          func(DecryptPhase)
+	onComplete             func()
 	frameCount             int
+	nextRow                int // Row where appended text starts
+	pending                int // First character that hasn't started decrypting
 	rng                    *rand.Rand
+
+	symbols          []rune        // Ciphertext symbols
+	blockSymbols     []rune        // Symbols a character is typed with
+	revealOrder      string        // Order characters are discovered in
+	frameDuration    time.Duration // Time one Update stands for
+	blockFrames      int           // Frames per typing symbol
+	scrambleFrames   int           // Frames of the fast scramble
+	scrambleInterval int           // Frames per fast scramble symbol
+	slowFrames       int           // Frames of the longest slow scramble
+	slowInterval     int           // Frames per slow scramble symbol
+	discoveryColor   string        // Color a character flashes when discovered
+	discoverySteps   int           // Steps from discoveryColor to the final color
+	discoveryFrames  int           // Frames of the discovery gradient
+	holdFrames       int           // Frames the decrypted text holds before completing
+}
+
+// DecryptPhase is a stage of the decrypt animation
+type DecryptPhase int
+
+const (
+	DecryptPhaseTyping     DecryptPhase = iota // Characters are typed in as blocks
+	DecryptPhaseDecrypting                     // Ciphertext scrambles until each character is discovered
+	DecryptPhaseComplete                       // The text is decrypted and has been held
+)
+
+// String returns the name of the phase
+func (p DecryptPhase) String() string {
+	switch p {
+	case DecryptPhaseTyping:
+		return "typing"
+	case DecryptPhaseDecrypting:
+		return "decrypting"
+	case DecryptPhaseComplete:
+		return "complete"
+	}
+	return fmt.Sprintf("DecryptPhase(%d)", int(p))
 }
 
 // DecryptCharacter represents a single character in the decryption effect
@@ -42,10 +84,24 @@ type DecryptCharacter struct {
 
 // DecryptAnimationFrame represents a single frame in a character's animation
 type DecryptAnimationFrame struct {
-	symbol rune
-	color  string
+	symbol   rune
+	color    string
+	duration int // Frames the symbol is shown for
+}
+
+// DecryptSymbolSets are the ciphertext symbol sets selectable by name
+var DecryptSymbolSets = map[string][]rune{
+	"default":  decryptDefaultSymbols(),
+	"ascii":    runeRange('!', '~'),
+	"hex":      []rune("0123456789ABCDEF"),
+	"binary":   []rune("01"),
+	"katakana": runeRange('ｦ', 'ﾝ'),
+	"blocks":   append(runeRange('█', '▟'), runeRange('─', '╿')...),
 }
 
+// DecryptRevealOrders lists the orders characters can be discovered in
+var DecryptRevealOrders = []string{"random", "left-to-right", "center-out", "word"}
+
 // DecryptConfig holds configuration for the decrypt effect
 type DecryptConfig struct {
 	Width                  int
@@ -57,12 +113,100 @@ type DecryptConfig struct {
 	FinalGradientStops     []string
 	FinalGradientSteps     int
 	FinalGradientDirection string
+
+	// SymbolSet names the ciphertext symbols in DecryptSymbolSets, default
+	// "default" (keyboard, block, box drawing and Latin characters). Symbols
+	// overrides the set with custom symbols.
+	SymbolSet string
+	Symbols   []rune
+
+	// RevealOrder is the order characters are discovered in: "random"
+	// (default), "left-to-right", "center-out" or "word"
+	RevealOrder string
+
+	// Phases, in order. Each typed character cycles through BlockSymbols
+	// (default ▉▓▒░) over BlockDuration. Once all are typed, every character
+	// scrambles fast for ScrambleDuration, then slowly for up to
+	// SlowDuration depending on RevealOrder, flashes DiscoveryColor and
+	// fades to its final color in DiscoverySteps over DiscoveryDuration.
+	// The decrypted text holds for HoldDuration before completing.
+	BlockSymbols      []rune
+	BlockDuration     time.Duration // Default 600ms
+	ScrambleDuration  time.Duration // Default 3s
+	ScrambleInterval  time.Duration // Time per fast scramble symbol, default 100ms
+	SlowDuration      time.Duration // Default 2s
+	SlowInterval      time.Duration // Time per slow scramble symbol, default 250ms
+	DiscoveryColor    string        // Default #ffffff
+	DiscoverySteps    int           // Default 15
+	DiscoveryDuration time.Duration // Default 750ms
+	HoldDuration      time.Duration // Default 2.5s
+
+	// FrameDuration is the time one Update stands for, default 50ms. Set it
+	// to the delay between frames so durations play out in real time.
+	FrameDuration time.Duration
+
+	// OnPhaseChange is called with the new phase whenever it changes, and
+	// OnComplete once the text is decrypted and held. Both are optional and
+	// run inside Update or AppendText.
+	OnPhaseChange func(DecryptPhase)
+	OnComplete    func()
 }
 
 // NewDecryptEffect creates a new decrypt effect with given configuration
 func NewDecryptEffect(config DecryptConfig) *DecryptEffect {
 	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
 
+	// Set defaults if not provided
+	if config.TypingSpeed <= 0 {
+		config.TypingSpeed = 1
+	}
+	if config.FrameDuration <= 0 {
+		config.FrameDuration = 50 * time.Millisecond
+	}
+	if config.BlockDuration == 0 {
+		config.BlockDuration = 600 * time.Millisecond
+	}
+	if config.ScrambleDuration == 0 {
+		config.ScrambleDuration = 3 * time.Second
+	}
+	if config.ScrambleInterval == 0 {
+		config.ScrambleInterval = 100 * time.Millisecond
+	}
+	if config.SlowDuration == 0 {
+		config.SlowDuration = 2 * time.Second
+	}
+	if config.SlowInterval == 0 {
+		config.SlowInterval = 250 * time.Millisecond
+	}
+	if config.DiscoveryColor == "" {
+		config.DiscoveryColor = "#ffffff"
+	}
+	if config.DiscoverySteps <= 0 {
+		config.DiscoverySteps = 15
+	}
+	if config.DiscoveryDuration == 0 {
+		config.DiscoveryDuration = 750 * time.Millisecond
+	}
+	if config.HoldDuration == 0 {
+		config.HoldDuration = 2500 * time.Millisecond
+	}
+	if len(config.BlockSymbols) == 0 {
+		config.BlockSymbols = []rune{'▉', '▓', '▒', '░'}
+	}
+	if config.RevealOrder == "" {
+		config.RevealOrder = "random"
+	}
+	if len(config.CiphertextColors) == 0 {
+		config.CiphertextColors = []string{"#008000", "#00cb00", "#00ff00"}
+	}
+	symbols := config.Symbols
+	if len(symbols) == 0 {
+		symbols = DecryptSymbolSets[config.SymbolSet]
+	}
+	if len(symbols) == 0 {
+		symbols = DecryptSymbolSets["default"]
+	}
+
 	effect := &DecryptEffect{
 		width:                  config.Width,
 		height:                 config.Height,
@@ -73,17 +217,48 @@ func NewDecryptEffect(config DecryptConfig) *DecryptEffect {
 		finalGradientStops:     config.FinalGradientStops,
 		finalGradientSteps:     config.FinalGradientSteps,
 		finalGradientDirection: config.FinalGradientDirection,
-		phase:                  "typing",
+		phase:                  DecryptPhaseTyping,
+		onPhaseChange:          config.OnPhaseChange,
+		onComplete:             config.OnComplete,
 		rng:                    rng,
-	}
+		symbols:                symbols,
+		blockSymbols:           config.BlockSymbols,
+		revealOrder:            config.RevealOrder,
+		frameDuration:          config.FrameDuration,
+		discoveryColor:         config.DiscoveryColor,
+		discoverySteps:         config.DiscoverySteps,
+	}
+
+	// Phases run in frames, so convert their durations
+	effect.blockFrames = max(effect.frames(config.BlockDuration)/len(config.BlockSymbols), 1)
+	effect.scrambleFrames = effect.frames(config.ScrambleDuration)
+	effect.scrambleInterval = max(effect.frames(config.ScrambleInterval), 1)
+	effect.slowFrames = effect.frames(config.SlowDuration)
+	effect.slowInterval = max(effect.frames(config.SlowInterval), 1)
+	effect.discoveryFrames = effect.frames(config.DiscoveryDuration)
+	effect.holdFrames = effect.frames(config.HoldDuration)
 
 	effect.init()
 	return effect
 }
 
+// frames converts a duration to a number of frames, rounding to the nearest
+func (d *DecryptEffect) frames(duration time.Duration) int {
+	return max(int((duration+d.frameDuration/2)/d.frameDuration), 0)
+}
+
+// typingFrames is the number of animation frames of the typing phase: the
+// block symbols and one ciphertext symbol
+func (d *DecryptEffect) typingFrames() int {
+	return len(d.blockSymbols) + 1
+}
+
 // Initialize the decrypt effect with characters and their animations
 func (d *DecryptEffect) init() {
-	lines := strings.Split(d.text, "\n")
+	var lines []string
+	if d.text != "" {
+		lines = strings.Split(d.text, "\n")
+	}
 
 	// Calculate centered position for multi-line text
 	startY := (d.height - len(lines)) / 2
@@ -92,134 +267,253 @@ func (d *DecryptEffect) init() {
 	}
 
 	// Create characters from all lines
-	for lineIdx, line := range lines {
-		startX := (d.width - len(line)) / 2
-		if startX < 0 {
-			startX = 0
+	d.nextRow = startY
+	for _, line := range lines {
+		d.addLine(line)
+	}
+
+	// Prepare animations for each character
+	d.prepareAnimations()
+}
+
+// addLine creates horizontally centered characters for a line at the next row
+func (d *DecryptEffect) addLine(line string) {
+	startX := (d.width - len(line)) / 2
+	if startX < 0 {
+		startX = 0
+	}
+
+	for charIdx, char := range line {
+		finalX := startX + charIdx
+		finalY := d.nextRow
+
+		// Skip characters that would be off-screen
+		if finalX >= d.width || finalY >= d.height {
+			continue
 		}
 
-		for charIdx, char := range line {
-			finalX := startX + charIdx
-			finalY := startY + lineIdx
-			
-			// Skip characters that would be off-screen
-			if finalX >= d.width || finalY >= d.height {
-				continue
+		d.chars = append(d.chars, DecryptCharacter{
+			original: char,
+			current:  char,
+			x:        finalX,
+			y:        finalY,
+			visible:  false,
+		})
+	}
+	d.nextRow++
+}
+
+// AppendText adds lines below the current text and types and decrypts them.
+// Once the text no longer fits, earlier lines scroll up off the screen.
+func (d *DecryptEffect) AppendText(text string) {
+	lines := strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+
+	// Scroll existing characters up to make room
+	if overflow := d.nextRow + len(lines) - d.height; overflow > 0 {
+		kept := d.chars[:0]
+		for i, char := range d.chars {
+			char.y -= overflow
+			if char.y >= 0 {
+				kept = append(kept, char)
+			} else if i < d.pending {
+				d.pending--
 			}
-			
-			d.chars = append(d.chars, DecryptCharacter{
-				original: char,
-				current:  char,
-				x:        finalX,
-				y:        finalY,
-				visible:  false,
-			})
 		}
+		d.chars = kept
+		d.nextRow -= overflow
 	}
 
-	// Prepare animations for each character
-	d.prepareAnimations()
+	first := len(d.chars)
+	for _, line := range lines {
+		d.addLine(line)
+	}
+
+	finalColors := d.calculateGradientColors()
+	ranks := d.revealRanks(d.chars[first:])
+	for i := first; i < len(d.chars); i++ {
+		d.prepareCharacter(&d.chars[i], finalColors[i], ranks[i-first])
+	}
+
+	// Go back to typing so the new characters appear
+	d.setPhase(DecryptPhaseTyping)
+	d.progress = d.currentProgress()
 }
 
 // Prepare the animations for each character
 func (d *DecryptEffect) prepareAnimations() {
-	encryptedSymbols := d.makeEncryptedSymbols()
-
 	// Calculate final colors with proper gradient
 	finalColors := d.calculateGradientColors()
+	ranks := d.revealRanks(d.chars)
 
 	for i := range d.chars {
-		char := &d.chars[i]
+		d.prepareCharacter(&d.chars[i], finalColors[i], ranks[i])
+	}
+}
 
-		// Get a random color for this character's ciphertext
-		ciphertextColor := d.ciphertextColors[d.rng.Intn(len(d.ciphertextColors))]
+// randomSymbol returns a random ciphertext symbol
+func (d *DecryptEffect) randomSymbol() rune {
+	return d.symbols[d.rng.Intn(len(d.symbols))]
+}
+
+// prepareCharacter builds the typing and decrypting animation for one
+// character. rank, from 0 to 1, is how late the character is discovered.
+func (d *DecryptEffect) prepareCharacter(char *DecryptCharacter, finalColor string, rank float64) {
+	// Get a random color for this character's ciphertext
+	ciphertextColor := d.ciphertextColors[d.rng.Intn(len(d.ciphertextColors))]
+
+	// Prepare typing animation (block characters, then one encrypted symbol)
+	animation := make([]DecryptAnimationFrame, 0, d.typingFrames())
+	for _, blockChar := range d.blockSymbols {
+		animation = append(animation, DecryptAnimationFrame{
+			symbol:   blockChar,
+			color:    ciphertextColor,
+			duration: d.blockFrames,
+		})
+	}
+	animation = append(animation, DecryptAnimationFrame{
+		symbol:   d.randomSymbol(),
+		color:    ciphertextColor,
+		duration: d.blockFrames,
+	})
+
+	// Fast decrypt phase
+	animation = d.appendScramble(animation, d.scrambleFrames, d.scrambleInterval, ciphertextColor)
+
+	// Slow decrypt phase, longer the later the character is discovered
+	slowFrames := d.slowInterval + int(rank*float64(max(d.slowFrames-d.slowInterval, 0)))
+	animation = d.appendScramble(animation, slowFrames, d.slowInterval, ciphertextColor)
+
+	// Discovered phase - create gradient transition to final color
+	discoveredGradient := motion.NewGradient(d.discoveryColor, finalColor).Steps(d.discoverySteps)
+	for i, color := range discoveredGradient {
+		// Spread the frames over the steps
+		steps := len(discoveredGradient)
+		frames := d.discoveryFrames*(i+1)/steps - d.discoveryFrames*i/steps
+		animation = append(animation, DecryptAnimationFrame{
+			symbol:   char.original,
+			color:    color,
+			duration: max(frames, 1),
+		})
+	}
 
-		// Prepare typing animation (block characters)
-		typingAnimation := make([]DecryptAnimationFrame, 0)
+	// Hold on final decrypted text
+	animation = append(animation, DecryptAnimationFrame{
+		symbol:   char.original,
+		color:    finalColor,
+		duration: max(d.holdFrames, 1),
+	})
 
-		// Add block characters with same color
-		blockChars := []rune{'▉', '▓', '▒', '░'}
-		for _, blockChar := range blockChars {
-			typingAnimation = append(typingAnimation, DecryptAnimationFrame{
-				symbol: blockChar,
-				color:  ciphertextColor,
-			})
-		}
+	char.animation = animation
+}
 
-		// Add one random encrypted symbol
-		symbol := encryptedSymbols[d.rng.Intn(len(encryptedSymbols))]
-		typingAnimation = append(typingAnimation, DecryptAnimationFrame{
-			symbol: symbol,
-			color:  ciphertextColor,
+// appendScramble adds random symbols to an animation, a new one every
+// interval frames for a total of frames
+func (d *DecryptEffect) appendScramble(animation []DecryptAnimationFrame, frames, interval int, color string) []DecryptAnimationFrame {
+	for frames > 0 {
+		duration := min(interval, frames)
+		animation = append(animation, DecryptAnimationFrame{
+			symbol:   d.randomSymbol(),
+			color:    color,
+			duration: duration,
 		})
+		frames -= duration
+	}
+	return animation
+}
 
-		// Prepare decrypting animations
-		decryptAnimation := make([]DecryptAnimationFrame, 0)
+// revealRanks orders characters by when they are discovered, from 0 for the
+// first to 1 for the last
+func (d *DecryptEffect) revealRanks(chars []DecryptCharacter) []float64 {
+	ranks := make([]float64, len(chars))
+	if len(chars) == 0 {
+		return ranks
+	}
 
-		// Fast decrypt phase (80 frames with short duration = 3)
-		for j := 0; j < 80; j++ {
-			symbol := encryptedSymbols[d.rng.Intn(len(encryptedSymbols))]
-			decryptAnimation = append(decryptAnimation, DecryptAnimationFrame{
-				symbol: symbol,
-				color:  ciphertextColor,
-			})
-		}
+	minX, maxX := chars[0].x, chars[0].x
+	minY, maxY := chars[0].y, chars[0].y
+	for _, char := range chars {
+		minX, maxX = min(minX, char.x), max(maxX, char.x)
+		minY, maxY = min(minY, char.y), max(maxY, char.y)
+	}
 
-		// Slow decrypt phase (1-15 frames with variable durations)
-		slowFrames := d.rng.Intn(15) + 1
-		for j := 0; j < slowFrames; j++ {
-			symbol := encryptedSymbols[d.rng.Intn(len(encryptedSymbols))]
-			decryptAnimation = append(decryptAnimation, DecryptAnimationFrame{
-				symbol: symbol,
-				color:  ciphertextColor,
-			})
+	switch d.revealOrder {
+	case "left-to-right":
+		for i, char := range chars {
+			if maxX > minX {
+				ranks[i] = float64(char.x-minX) / float64(maxX-minX)
+			}
 		}
 
-		// Discovered phase - create gradient transition from white to final color
-		discoveredGradient := d.createSimpleGradient("#ffffff", finalColors[i], 15)
-		for _, color := range discoveredGradient {
-			decryptAnimation = append(decryptAnimation, DecryptAnimationFrame{
-				symbol: char.original,
-				color:  color,
-			})
+	case "center-out":
+		// Cells are about twice as tall as wide
+		centerX := float64(minX+maxX) / 2
+		centerY := float64(minY+maxY) / 2
+		distances := make([]float64, len(chars))
+		farthest := 0.0
+		for i, char := range chars {
+			dx := float64(char.x) - centerX
+			dy := (float64(char.y) - centerY) * 2
+			distances[i] = math.Sqrt(dx*dx + dy*dy)
+			farthest = math.Max(farthest, distances[i])
 		}
-		
-		// Hold on final decrypted text for remaining duration
-		for j := 0; j < 50; j++ {
-			decryptAnimation = append(decryptAnimation, DecryptAnimationFrame{
-				symbol: char.original,
-				color:  finalColors[i],
-			})
+		for i := range chars {
+			if farthest > 0 {
+				ranks[i] = distances[i] / farthest
+			}
 		}
 
-		char.animation = append(typingAnimation, decryptAnimation...)
-	}
-}
-
-// Create a list of encrypted symbols
-func (d *DecryptEffect) makeEncryptedSymbols() []rune {
-	var symbols []rune
-
-	// Keyboard characters (33-126)
-	for i := 33; i <= 126; i++ {
-		symbols = append(symbols, rune(i))
-	}
+	case "word":
+		// Words are runs of non-space characters in reading order, each
+		// discovered all at once
+		words := make([]int, len(chars))
+		word, inWord := -1, false
+		for i, char := range chars {
+			if i > 0 && char.y != chars[i-1].y {
+				inWord = false
+			}
+			if char.original == ' ' {
+				inWord = false
+				words[i] = max(word, 0)
+				continue
+			}
+			if !inWord {
+				word++
+				inWord = true
+			}
+			words[i] = word
+		}
+		for i := range chars {
+			if word > 0 {
+				ranks[i] = float64(words[i]) / float64(word)
+			}
+		}
 
-	// Block characters (9608-9631)
-	for i := 9608; i <= 9631; i++ {
-		symbols = append(symbols, rune(i))
+	default:
+		for i := range chars {
+			ranks[i] = d.rng.Float64()
+		}
 	}
 
-	// Box drawing characters (9472-9599)
-	for i := 9472; i <= 9599; i++ {
-		symbols = append(symbols, rune(i))
-	}
+	return ranks
+}
 
-	// Misc characters (174-451)
-	for i := 174; i <= 451; i++ {
-		symbols = append(symbols, rune(i))
+// runeRange returns the runes from first to last inclusive
+func runeRange(first, last rune) []rune {
+	runes := make([]rune, 0, last-first+1)
+	for r := first; r <= last; r++ {
+		runes = append(runes, r)
 	}
+	return runes
+}
 
+// decryptDefaultSymbols returns the keyboard, block, box drawing and Latin
+// characters the decrypt effect has always used
+func decryptDefaultSymbols() []rune {
+	var symbols []rune
+	symbols = append(symbols, runeRange(33, 126)...)    // Keyboard characters
+	symbols = append(symbols, runeRange(9608, 9631)...) // Block characters
+	symbols = append(symbols, runeRange(9472, 9599)...) // Box drawing characters
+	symbols = append(symbols, runeRange(174, 451)...)   // Misc characters
 	return symbols
 }
 
@@ -242,6 +536,8 @@ func (d *DecryptEffect) calculateGradientColors() []string {
 		return colors
 	}
 
+	gradient := motion.NewGradient(d.finalGradientStops...)
+
 	// Find min/max coordinates for normalization
 	minX, maxX := d.width, 0
 	minY, maxY := d.height, 0
@@ -277,94 +573,39 @@ func (d *DecryptEffect) calculateGradientColors() []string {
 			}
 		}
 
-		// Map ratio to gradient stops
-		step := int(ratio * float64(len(d.finalGradientStops)-1))
-		if step >= len(d.finalGradientStops) {
-			step = len(d.finalGradientStops) - 1
-		}
-		if step < 0 {
-			step = 0
-		}
-
-		colors[i] = d.finalGradientStops[step]
+		colors[i] = gradient.At(ratio)
 	}
 
 	return colors
 }
 
-// Create a simple gradient between two colors with specified steps
-func (d *DecryptEffect) createSimpleGradient(startColor, endColor string, steps int) []string {
-	if steps <= 0 {
-		return []string{endColor}
-	}
-
-	gradient := make([]string, steps)
-
-	// Parse start color
-	startR, startG, startB := d.parseHexColor(startColor)
-
-	// Parse end color
-	endR, endG, endB := d.parseHexColor(endColor)
-
-	// Calculate step increments
-	rStep := float64(endR-startR) / float64(steps-1)
-	gStep := float64(endG-startG) / float64(steps-1)
-	bStep := float64(endB-startB) / float64(steps-1)
-
-	// Generate gradient colors
-	for i := 0; i < steps; i++ {
-		r := int(float64(startR) + float64(i)*rStep)
-		g := int(float64(startG) + float64(i)*gStep)
-		b := int(float64(startB) + float64(i)*bStep)
-
-		// Clamp values to 0-255
-		r = d.clamp(r, 0, 255)
-		g = d.clamp(g, 0, 255)
-		b = d.clamp(b, 0, 255)
-
-		gradient[i] = fmt.Sprintf("#%02x%02x%02x", r, g, b)
-	}
-
-	return gradient
-}
-
-// Parse hex color string to RGB values
-func (d *DecryptEffect) parseHexColor(hex string) (int, int, int) {
-	if len(hex) < 8 || hex[0] != '#' {
-		// Default to white if invalid
-		return 255, 255, 255
-	}
-
-	r, _ := strconv.ParseInt(hex[1:3], 16, 64)
-	g, _ := strconv.ParseInt(hex[3:5], 16, 64)
-	b, _ := strconv.ParseInt(hex[5:7], 16, 64)
-
-	return int(r), int(g), int(b)
-}
-
-// Clamp value between min and max
-func (d *DecryptEffect) clamp(value, min, max int) int {
-	if value < min {
-		return min
-	}
-	if value > max {
-		return max
-	}
-	return value
-}
-
 // Update advances the decrypt animation by one frame
 func (d *DecryptEffect) Update() {
 	d.frameCount++
 
 	switch d.phase {
-	case "typing":
+	case DecryptPhaseTyping:
 		d.updateTypingPhase()
-	case "decrypting":
+	case DecryptPhaseDecrypting:
 		d.updateDecryptingPhase()
-	case "complete":
+	case DecryptPhaseComplete:
 		return
 	}
+	d.progress = math.Max(d.progress, d.currentProgress())
+}
+
+// setPhase moves to a new phase and calls the callbacks
+func (d *DecryptEffect) setPhase(phase DecryptPhase) {
+	if d.phase == phase {
+		return
+	}
+	d.phase = phase
+	if d.onPhaseChange != nil {
+		d.onPhaseChange(phase)
+	}
+	if phase == DecryptPhaseComplete && d.onComplete != nil {
+		d.onComplete()
+	}
 }
 
 // Update the typing phase of the animation
@@ -378,9 +619,13 @@ func (d *DecryptEffect) updateTypingPhase() {
 				// Find the next invisible character
 				for j := 0; j < len(d.chars); j++ {
 					if !d.chars[j].visible {
+						// Start on the first block symbol, never the plaintext
+						first := d.chars[j].animation[0]
 						d.chars[j].visible = true
 						d.chars[j].frameIndex = 0
 						d.chars[j].duration = 0
+						d.chars[j].current = first.symbol
+						d.chars[j].color = first.color
 						break
 					}
 				}
@@ -397,16 +642,14 @@ func (d *DecryptEffect) updateTypingPhase() {
 
 	// Transition to decrypting phase when typing is complete
 	if len(d.getVisibleChars()) == len(d.chars) && d.allCharsStill() {
-		d.phase = "decrypting"
-		// Reset frame indices for decrypting phase
-		for i := range d.chars {
-			// Set frame index to start of decrypting animation (after typing frames)
-			typingFrames := 5 // 4 block chars + 1 encrypted symbol
-			if d.chars[i].frameIndex < typingFrames {
-				d.chars[i].frameIndex = typingFrames
-			}
+		d.setPhase(DecryptPhaseDecrypting)
+		// Start the new characters at the beginning of the decrypting
+		// animation together, so they are discovered in reveal order
+		for i := d.pending; i < len(d.chars); i++ {
+			d.chars[i].frameIndex = d.typingFrames()
 			d.chars[i].duration = 0
 		}
+		d.pending = len(d.chars)
 	}
 }
 
@@ -415,7 +658,7 @@ func (d *DecryptEffect) allCharsStill() bool {
 	for _, char := range d.chars {
 		if char.visible {
 			// Check if character is still in typing phase
-			if char.frameIndex < 5 { // 5 typing frames (4 blocks + 1 encrypted)
+			if char.frameIndex < d.typingFrames() {
 				return false
 			}
 		}
@@ -445,7 +688,7 @@ func (d *DecryptEffect) updateDecryptingPhase() {
 
 	// Move to complete phase when all done
 	if allDone {
-		d.phase = "complete"
+		d.setPhase(DecryptPhaseComplete)
 	}
 }
 
@@ -458,18 +701,10 @@ func (d *DecryptEffect) updateCharacter(char *DecryptCharacter) {
 	// Update duration counter
 	char.duration++
 
-	// Determine frame duration based on current animation phase
-	frameDuration := 3 // Default for typing phase (slowed down)
-
-	// Check if we're in the decrypting phase (past the typing frames)
-	typingFrames := 5 // 4 block chars + 1 encrypted symbol
-	if char.frameIndex >= typingFrames {
-		// Decrypting phase - much slower variable durations
-		if d.rng.Intn(100) <= 40 {
-			frameDuration = d.rng.Intn(100) + 80 // Longer duration (80-180)
-		} else {
-			frameDuration = d.rng.Intn(10) + 10 // Shorter duration (10-19)
-		}
+	// Each frame is shown for its own duration
+	frameDuration := 1
+	if char.frameIndex < len(char.animation) {
+		frameDuration = char.animation[char.frameIndex].duration
 	}
 
 	// Advance frame if duration has elapsed
@@ -528,8 +763,10 @@ func (d *DecryptEffect) Render() string {
 
 // Reset restarts the animation from the beginning
 func (d *DecryptEffect) Reset() {
-	d.phase = "typing"
+	d.setPhase(DecryptPhaseTyping)
 	d.frameCount = 0
+	d.progress = 0
+	d.pending = 0
 
 	// Reset character states
 	for i := range d.chars {
@@ -542,3 +779,44 @@ func (d *DecryptEffect) Reset() {
 	// Reprepare animations
 	d.prepareAnimations()
 }
+
+// IsComplete returns whether the animation is finished
+func (d *DecryptEffect) IsComplete() bool {
+	return d.phase == DecryptPhaseComplete
+}
+
+// Phase returns the current phase of the animation
+func (d *DecryptEffect) Phase() DecryptPhase {
+	return d.phase
+}
+
+// Progress returns how far the animation is from 0 to 1, the share of all
+// character animation frames played
+func (d *DecryptEffect) Progress() float64 {
+	if d.phase == DecryptPhaseComplete {
+		return 1
+	}
+	return d.progress
+}
+
+// currentProgress works out the share of character animation frames played
+func (d *DecryptEffect) currentProgress() float64 {
+	played, total := 0, 0
+	for _, char := range d.chars {
+		for i, frame := range char.animation {
+			total += frame.duration
+			if !char.visible || i > char.frameIndex {
+				continue
+			}
+			if i < char.frameIndex {
+				played += frame.duration
+			} else {
+				played += min(char.duration, frame.duration)
+			}
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(played) / float64(total)
+}
@@ -0,0 +1,37 @@
+package animations
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDecryptTypedCharactersHidePlaintext(t *testing.T) {
+	tests := []struct {
+		name          string
+		blockDuration time.Duration
+	}{
+		{"default", 0},
+		{"one frame", time.Millisecond},
+		{"long", time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDecryptEffect(DecryptConfig{
+				Width:         40,
+				Height:        10,
+				Text:          "TOP SECRET",
+				Symbols:       []rune("0123456789"),
+				BlockDuration: tt.blockDuration,
+			})
+			for frame := 0; frame < 200 && d.Phase() == DecryptPhaseTyping; frame++ {
+				d.Update()
+				for _, char := range d.chars {
+					if char.visible && char.original != ' ' && char.current == char.original {
+						t.Fatalf("frame %d: typed %q shows its plaintext", frame, char.original)
+					}
+				}
+			}
+		})
+	}
+}
@@ -1,40 +1,163 @@
 package animations
 
 import (
+	"math"
 	"math/rand"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss/v2"
 )
 
+// fireMaxHeat is the heat of a fully burning source cell
+const fireMaxHeat = 36
+
 // FireEffect implements PSX DOOM-style fire algorithm
 type FireEffect struct {
 	width   int      // Terminal width
 	height  int      // Terminal height
-	buffer  []int    // Heat values (0-36), size = width * height
+	rows    int      // Simulated rows, twice the height in half-block mode
+	buffer  []int    // Heat values (0-36), size = width * rows
 	palette []string // Hex color codes from theme
 	chars   []rune   // Fire characters for density
+
+	// Flame shape
+	maxHeight    int                          // Tallest flame in terminal rows, 0 = 90% of the screen
+	decayProfile string                       // "classic", "linear", "exponential" or "custom"
+	decayFunc    func(height float64) float64 // Extra decay for "custom"
+	halfBlock    bool                         // Render two fire rows per terminal row
+	baseRow      int                          // Lowest source row, flame height is measured from it
+
+	// Heat sources
+	source       string   // "bottom", "points" or "mask"
+	sourcePoints [][2]int // Burning cells for "points"
+	sourceMask   []string // Burning glyphs for "mask"
+	sources      []int    // Buffer indexes fed every frame
+	sourceHeat   float64  // Current heat of the sources
+	targetHeat   float64  // Heat the sources are ramping towards
+	heatStep     float64  // Heat change per frame while ramping
+
+	wind float64 // Horizontal drift per row, negative blows left
+}
+
+// FireConfig holds the settings of a fire effect
+type FireConfig struct {
+	Width        int
+	Height       int
+	Palette      []string // Colors from coolest to hottest
+	Source       string   // Heat source: "bottom" (default), "points" or "mask"
+	SourcePoints [][2]int // (x, y) cells that burn when Source is "points"
+	SourceMask   []string // Rows whose non-space cells burn when Source is "mask", centered on screen
+	Wind         float64  // Average drift in cells per row, negative blows left (-2 to 2 is sensible)
+
+	MaxHeight    int    // Tallest flame in rows above the lowest source, 0 = 90% of the screen
+	DecayProfile string // How fast flames cool with height: "classic" (default), "linear", "exponential" or "custom"
+	// DecayFunc returns the extra heat lost per row at a relative flame
+	// height from 0 (source) to 1 (MaxHeight). Used by DecayProfile "custom".
+	DecayFunc func(height float64) float64
+	Chars     []rune // Character ramp from coolest to hottest, default ' ', '░', '▒', '▓', '█'
+	HalfBlock bool   // Render with ▀/▄ and background colors, doubling vertical resolution
 }
 
 // NewFireEffect creates a new fire effect with given dimensions and theme palette
 func NewFireEffect(width, height int, palette []string) *FireEffect {
+	return NewFireEffectWithConfig(FireConfig{
+		Width:   width,
+		Height:  height,
+		Palette: palette,
+		Wind:    -0.5, // Classic DOOM fire leans slightly left
+	})
+}
+
+// NewFireEffectWithConfig creates a fire effect with custom heat sources and wind
+func NewFireEffectWithConfig(config FireConfig) *FireEffect {
+	if config.Source == "" {
+		config.Source = "bottom"
+	}
+	if len(config.Chars) == 0 {
+		config.Chars = []rune{' ', '░', '▒', '▓', '█'}
+	}
+	if config.DecayProfile == "" {
+		config.DecayProfile = "classic"
+		if config.DecayFunc != nil {
+			config.DecayProfile = "custom"
+		}
+	}
+
 	f := &FireEffect{
-		width:   width,
-		height:  height,
-		palette: palette,
-		chars:   []rune{' ', '░', '▒', '▓', '█'},
+		width:        config.Width,
+		height:       config.Height,
+		palette:      config.Palette,
+		chars:        config.Chars,
+		maxHeight:    config.MaxHeight,
+		decayProfile: config.DecayProfile,
+		decayFunc:    config.DecayFunc,
+		halfBlock:    config.HalfBlock,
+		source:       config.Source,
+		sourcePoints: config.SourcePoints,
+		sourceMask:   config.SourceMask,
+		sourceHeat:   fireMaxHeat,
+		targetHeat:   fireMaxHeat,
+		wind:         config.Wind,
 	}
 	f.init()
 	return f
 }
 
-// Initialize fire buffer with bottom row as heat source
+// Initialize fire buffer and place the heat sources
 func (f *FireEffect) init() {
-	f.buffer = make([]int, f.width*f.height)
+	f.rows = f.height
+	if f.halfBlock {
+		f.rows = f.height * 2
+	}
+	f.buffer = make([]int, f.width*f.rows)
+	f.sources = f.sources[:0]
+	f.baseRow = 0
 
-	// Set bottom row to maximum heat (fire source)
-	for i := 0; i < f.width; i++ {
-		f.buffer[(f.height-1)*f.width+i] = 36
+	switch f.source {
+	case "points":
+		for _, p := range f.sourcePoints {
+			f.addSource(p[0], p[1])
+		}
+	case "mask":
+		// Center the mask, every visible glyph cell is a heat source
+		top := (f.height - len(f.sourceMask)) / 2
+		for row, line := range f.sourceMask {
+			runes := []rune(line)
+			left := (f.width - len(runes)) / 2
+			for col, r := range runes {
+				if r != ' ' {
+					f.addSource(left+col, top+row)
+				}
+			}
+		}
+	default:
+		// Bottom row is the fire source
+		for x := 0; x < f.width; x++ {
+			f.addSource(x, f.height-1)
+		}
+	}
+
+	f.feedSources()
+}
+
+// addSource marks a terminal cell as a heat source, ignoring cells off screen
+func (f *FireEffect) addSource(x, y int) {
+	if x < 0 || x >= f.width || y < 0 || y >= f.height {
+		return
+	}
+	scale := f.rows / f.height
+	for sub := 0; sub < scale; sub++ {
+		row := y*scale + sub
+		f.sources = append(f.sources, row*f.width+x)
+		f.baseRow = max(f.baseRow, row)
+	}
+}
+
+// feedSources sets every source cell to the current source heat
+func (f *FireEffect) feedSources() {
+	heat := int(math.Round(f.sourceHeat))
+	for _, i := range f.sources {
+		f.buffer[i] = heat
 	}
 }
 
@@ -43,6 +166,45 @@ func (f *FireEffect) UpdatePalette(palette []string) {
 	f.palette = palette
 }
 
+// SetWind changes the horizontal drift, negative values blow left
+func (f *FireEffect) SetWind(wind float64) {
+	f.wind = wind
+}
+
+// Extinguish cools the heat sources to nothing over the given number of
+// frames, so the flames die down. Zero puts the fire out at once.
+func (f *FireEffect) Extinguish(frames int) {
+	f.rampSources(0, frames)
+}
+
+// Reignite heats the sources back to full over the given number of frames
+func (f *FireEffect) Reignite(frames int) {
+	f.rampSources(fireMaxHeat, frames)
+}
+
+// IsExtinguished reports whether the sources are out and no flames are left
+func (f *FireEffect) IsExtinguished() bool {
+	if f.sourceHeat > 0 {
+		return false
+	}
+	for _, heat := range f.buffer {
+		if heat > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *FireEffect) rampSources(target float64, frames int) {
+	f.targetHeat = target
+	if frames <= 0 {
+		f.sourceHeat = target
+		f.heatStep = 0
+		return
+	}
+	f.heatStep = math.Abs(target-f.sourceHeat) / float64(frames)
+}
+
 // Resize reinitializes the fire effect with new dimensions
 func (f *FireEffect) Resize(width, height int) {
 	f.width = width
@@ -52,33 +214,26 @@ func (f *FireEffect) Resize(width, height int) {
 
 // spreadFire propagates heat upward with random decay
 func (f *FireEffect) spreadFire(from int) {
-	// Random horizontal offset (0-3) for chaos
-	offset := rand.Intn(4)
-	to := from - f.width - offset + 1
+	// Random jitter of one cell either way plus the wind drift
+	shift := rand.Intn(3) - 1 + int(math.Round(f.wind*rand.Float64()*2))
+	to := from - f.width + shift
 
 	// Bounds check
 	if to < 0 || to >= len(f.buffer) {
 		return
 	}
 
-	// Calculate target row
+	// Flame height relative to the tallest allowed flame
 	toY := to / f.width
-	hardLimit := f.height / 10          // Top 10% - absolute no-go zone
-	fadeZoneStart := (f.height * 4) / 5 // Top 80% - start heavy decay
+	height := float64(f.baseRow-toY) / float64(f.flameRows())
 
-	// Hard limit - no propagation into top 10%
-	if toY < hardLimit {
+	// Hard limit - no propagation above the tallest flame
+	if height > 1 {
 		return
 	}
 
-	// Random decay (0 or 1)
-	decay := rand.Intn(2)
-
-	// Aggressive decay in fade zone (between 10% and 80% from top)
-	if toY < fadeZoneStart {
-		// Add 2-6 extra decay for smooth gradient fade
-		decay += rand.Intn(5) + 2
-	}
+	// Random decay (0 or 1) plus the profile's extra decay for this height
+	decay := rand.Intn(2) + f.extraDecay(max(height, 0))
 
 	newHeat := f.buffer[from] - decay
 	if newHeat < 0 {
@@ -88,20 +243,76 @@ func (f *FireEffect) spreadFire(from int) {
 	f.buffer[to] = newHeat
 }
 
+// flameRows returns the tallest flame in simulated rows
+func (f *FireEffect) flameRows() int {
+	if f.maxHeight > 0 {
+		return f.maxHeight * f.rows / f.height
+	}
+	return f.rows - f.rows/10 // Top 10% - absolute no-go zone
+}
+
+// extraDecay returns the heat lost on top of the base decay at a relative
+// flame height, rounding fractions up at random so averages are kept
+func (f *FireEffect) extraDecay(height float64) int {
+	// Linear and exponential spread the heat left after the base decay
+	// over the flame, so flames burn out near their maximum height
+	rows := float64(f.flameRows())
+	budget := math.Max(fireMaxHeat-0.5*rows, 0) / rows
+
+	var extra float64
+	switch f.decayProfile {
+	case "linear":
+		extra = budget * 2 * height
+	case "exponential":
+		extra = budget * (math.Exp(4*height) - 1) / ((math.Exp(4)-1)/4 - 1)
+	case "custom":
+		if f.decayFunc != nil {
+			// Custom decay is given per terminal row
+			extra = f.decayFunc(height) / float64(f.rows/f.height)
+		}
+	default:
+		// Classic: cool gently near the source, then fade fast above
+		// the bottom fifth of the screen
+		if height > 2.0/9 {
+			extra = float64(rand.Intn(5)+2) / float64(f.rows/f.height)
+		}
+	}
+
+	whole := math.Floor(extra)
+	if rand.Float64() < extra-whole {
+		whole++
+	}
+	return int(whole)
+}
+
 // Update advances the fire simulation by one frame
 func (f *FireEffect) Update() {
+	// Ramp the sources towards the target heat
+	if f.sourceHeat < f.targetHeat {
+		f.sourceHeat = math.Min(f.sourceHeat+f.heatStep, f.targetHeat)
+	} else if f.sourceHeat > f.targetHeat {
+		f.sourceHeat = math.Max(f.sourceHeat-f.heatStep, f.targetHeat)
+	}
+
 	// Process all pixels from bottom to top
 	// (Fire spreads upward, must process bottom row first)
-	for y := f.height - 1; y > 0; y-- {
+	for y := f.rows - 1; y > 0; y-- {
 		for x := 0; x < f.width; x++ {
 			index := y*f.width + x
 			f.spreadFire(index)
 		}
 	}
+
+	// Sources inside the screen can be overwritten by flames from below
+	f.feedSources()
 }
 
 // Render converts the fire buffer to colored text output
 func (f *FireEffect) Render() string {
+	if f.halfBlock {
+		return f.renderHalfBlock()
+	}
+
 	var lines []string
 
 	// Render across full height - low heat at top will naturally fade to black/background
@@ -116,23 +327,16 @@ func (f *FireEffect) Render() string {
 				continue
 			}
 
-			// Map heat to character (0-36 heat → 5 chars)
-			charIndex := heat / 7
+			// Map heat to character (0-36 heat → ramp, 7 heat per step with 5 chars)
+			charIndex := heat * len(f.chars) / 35
 			if charIndex >= len(f.chars) {
 				charIndex = len(f.chars) - 1
 			}
 			char := f.chars[charIndex]
 
-			// Map heat to color from palette
-			colorIndex := heat * (len(f.palette) - 1) / 36
-			if colorIndex >= len(f.palette) {
-				colorIndex = len(f.palette) - 1
-			}
-			colorHex := f.palette[colorIndex]
-
 			// Render colored character
 			styled := lipgloss.NewStyle().
-				Foreground(lipgloss.Color(colorHex)).
+				Foreground(lipgloss.Color(f.heatColor(heat))).
 				Render(string(char))
 			line.WriteString(styled)
 		}
@@ -141,3 +345,49 @@ func (f *FireEffect) Render() string {
 
 	return strings.Join(lines, "\n")
 }
+
+// renderHalfBlock draws two fire rows per terminal row, the upper one as the
+// foreground of ▀ and the lower one as its background
+func (f *FireEffect) renderHalfBlock() string {
+	var lines []string
+
+	for y := 0; y < f.height; y++ {
+		var line strings.Builder
+		for x := 0; x < f.width; x++ {
+			top := f.buffer[(2*y)*f.width+x]
+			bottom := f.buffer[(2*y+1)*f.width+x]
+
+			var styled string
+			switch {
+			case top < 3 && bottom < 3:
+				styled = " "
+			case bottom < 3:
+				styled = lipgloss.NewStyle().
+					Foreground(lipgloss.Color(f.heatColor(top))).
+					Render("▀")
+			case top < 3:
+				styled = lipgloss.NewStyle().
+					Foreground(lipgloss.Color(f.heatColor(bottom))).
+					Render("▄")
+			default:
+				styled = lipgloss.NewStyle().
+					Foreground(lipgloss.Color(f.heatColor(top))).
+					Background(lipgloss.Color(f.heatColor(bottom))).
+					Render("▀")
+			}
+			line.WriteString(styled)
+		}
+		lines = append(lines, line.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// heatColor maps heat to a color from the palette
+func (f *FireEffect) heatColor(heat int) string {
+	colorIndex := heat * (len(f.palette) - 1) / fireMaxHeat
+	if colorIndex >= len(f.palette) {
+		colorIndex = len(f.palette) - 1
+	}
+	return f.palette[colorIndex]
+}
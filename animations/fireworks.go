@@ -5,6 +5,7 @@ import (
 	"math/rand"
 	"strings"
 
+	"github.com/Nomadcxx/sysc-Go/animations/motion"
 	"github.com/charmbracelet/lipgloss/v2"
 	"gonum.org/v1/gonum/spatial/r2"
 )
@@ -16,68 +17,214 @@ type Particle struct {
 	t                float64 // Progress (0-1)
 	char             rune    // Character to display
 	style            lipgloss.Style
-	phase            int    // 0=launch, 1=explosion, 2=fall
+	phase            int    // 0=launch, 1=explosion, 2=fall, 3=waiting to split off
 	color            string // Current color
 	targetX, targetY int    // Final position
+
+	// Pool bookkeeping
+	alive bool // In use; unused particles are on the pool's free list
+	shell int  // Slot of the shell the particle belongs to
+
+	// Physics mode
+	vel      r2.Vec   // Velocity in cells per frame
+	life     int      // Frames left before the star burns out or splits
+	parent   int      // Star a waiting particle splits off from, -1 for none
+	trail    []r2.Vec // Recent positions, newest last
+	trailLen int      // Positions kept in trail
+
+	// Text bursts
+	glyph     rune // Character the particle shows once it settles at its target
+	hasTarget bool // Particle settles at targetX, targetY when the shell explodes
+	hold      int  // Frames left to stay at the target before falling
+}
+
+// fireworkShell is a group of particles launched and burst together
+type fireworkShell struct {
+	kind      string // Entry of FireworksShellTypes, or "text"
+	particles []int  // Pool indices of the shell's particles
+	live      int    // Particles not yet returned to the pool
+	explode   bool   // Launch finished this frame (bezier mode)
+	fall      bool   // Explosion finished this frame (bezier mode)
 }
 
+// fireworkGlyph is a character of the text finale and where it settles
+type fireworkGlyph struct {
+	x, y int
+	char rune
+}
+
+// fireworkShellSize is the number of particles in a regular shell
+const fireworkShellSize = 25
+
 // FireworksEffect implements fireworks animation
 type FireworksEffect struct {
 	width, height int
-	particles     []Particle
+	particles     []Particle // Particle pool
+	free          []int      // Indices of unused particles
 	palette       []string
 	frame         int
-	shells        [][]int // Indices of particles in each shell
+	shells        []fireworkShell // Shell slots
+	freeShells    []int           // Indices of unused shell slots
 	launchDelay   int
-	activeShells  int
+	launched      int // Shells launched since the last text finale
+
+	mode         string             // "bezier" or "physics"
+	gravity      float64            // Rows per frame per frame
+	drag         float64            // Share of velocity lost per frame
+	shellWeights map[string]float64 // Relative odds of each shell type
+
+	maxParticles int // Size of the particle pool, 0 to fit the screen
+	maxShells    int // Shells in the air at once, 0 to fit the screen
+
+	text       string          // Text the finale shell spells out
+	textHold   int             // Frames the text stays up before falling
+	glyphs     []fireworkGlyph // Layout of the text
+	showLength int             // Regular shells launched before each text finale
+	finale     bool            // The text finale is in the air
+
+	// Scratch buffers reused by Render
+	canvas [][]rune
+	styles [][]lipgloss.Style
+}
+
+// fireworkChars are the characters of firework particles
+var fireworkChars = []rune{'*', '•', '○', '●', '◦', '◉', '◌', '◍', '◎', '◐', '+', 'x', '✦', '✧', '✨', '✪', '✫', '✬', '✭', '✮'}
+
+// FireworksShellTypes lists the shell types shells burst as
+var FireworksShellTypes = []string{"peony", "chrysanthemum", "ring", "willow", "crossette", "multistage"}
+
+// FireworksConfig holds configuration for the fireworks effect
+type FireworksConfig struct {
+	Width   int
+	Height  int
+	Palette []string
+
+	// Mode "bezier" (default) moves particles along curves; "physics"
+	// integrates velocity, gravity and drag every frame. Both burst shells
+	// of every type in FireworksShellTypes, picked by ShellWeights.
+	Mode         string
+	Gravity      float64            // Rows per frame per frame in physics mode, default 0.03
+	Drag         float64            // Share of velocity lost per frame in physics mode, default 0.05
+	ShellWeights map[string]float64 // Relative odds of each shell type, default all equal
+
+	// Text is spelled out by a finale shell after the others, its particles
+	// settling into the glyphs of the text. Any non-space character counts, so
+	// ASCII art outlines work too.
+	Text     string
+	TextHold int // Frames the text stays up before falling, default 40
+
+	// MaxShells caps the shells in the air at once, default one per 15
+	// columns and at least 6. MaxParticles sizes the particle pool, default
+	// enough for MaxShells shells and the text. Shells wait for particles
+	// to be free before launching.
+	MaxShells    int
+	MaxParticles int
 }
 
 // NewFireworksEffect creates a new fireworks effect
 func NewFireworksEffect(width, height int, palette []string) *FireworksEffect {
+	return NewFireworksEffectWithConfig(FireworksConfig{
+		Width:   width,
+		Height:  height,
+		Palette: palette,
+	})
+}
+
+// NewFireworksEffectWithConfig creates a fireworks effect with physics and
+// shell types
+func NewFireworksEffectWithConfig(config FireworksConfig) *FireworksEffect {
+	if config.Mode == "" {
+		config.Mode = "bezier"
+	}
+	if config.Gravity == 0 {
+		config.Gravity = 0.03
+	}
+	if config.Drag == 0 {
+		config.Drag = 0.05
+	}
+	if config.TextHold == 0 {
+		config.TextHold = 40
+	}
+	if len(config.ShellWeights) == 0 {
+		config.ShellWeights = make(map[string]float64)
+		for _, shellType := range FireworksShellTypes {
+			config.ShellWeights[shellType] = 1
+		}
+	}
+
 	fw := &FireworksEffect{
-		width:        width,
-		height:       height,
-		palette:      palette,
+		width:        config.Width,
+		height:       config.Height,
+		palette:      config.Palette,
 		frame:        0,
 		launchDelay:  0,
-		activeShells: 0,
+		mode:         config.Mode,
+		gravity:      config.Gravity,
+		drag:         config.Drag,
+		shellWeights: config.ShellWeights,
+		maxShells:    config.MaxShells,
+		maxParticles: config.MaxParticles,
+		text:         config.Text,
+		textHold:     config.TextHold,
 	}
 	fw.init()
 	return fw
 }
 
-// Initialize fireworks with particles
+// Initialize fireworks with an empty sky and a pool of free particles
 func (fw *FireworksEffect) init() {
-	// Create particles - for sysc-greet we'll create a fixed number of particles
-	// that continuously animate rather than animating text characters
-	particleCount := fw.width * 2
-	fw.particles = make([]Particle, particleCount)
+	fw.layoutText()
+	maxShells := fw.maxShells
+	if maxShells <= 0 {
+		maxShells = max(fw.width/15, 6)
+	}
+	maxParticles := fw.maxParticles
+	if maxParticles <= 0 {
+		maxParticles = maxShells*fireworkShellSize + len(fw.glyphs)
+	}
 
-	// Various characters for firework particles
-	chars := []rune{'*', '•', '○', '●', '◦', '◉', '◌', '◍', '◎', '◐', '+', 'x', '✦', '✧', '✨', '✪', '✫', '✬', '✭', '✮'}
+	// Wider screens launch more shells between finales, as they always have
+	fw.showLength = max(fw.width*2/fireworkShellSize, 1)
 
-	for i := 0; i < particleCount; i++ {
-		fw.particles[i] = Particle{
-			char:  chars[rand.Intn(len(chars))],
-			t:     1, // Set to 1 so particles don't render until launched
-			phase: 0,
-			pos:   r2.Vec{X: -100, Y: -100}, // Off-screen initially
-		}
+	fw.particles = make([]Particle, maxParticles)
+	fw.free = make([]int, 0, maxParticles)
+	for i := len(fw.particles) - 1; i >= 0; i-- {
+		fw.particles[i].parent = -1
+		fw.free = append(fw.free, i)
 	}
 
-	// Create shells (groups of particles that explode together)
-	shellSize := 25
-	fw.shells = nil // Clear existing shells
-	for i := 0; i < len(fw.particles); i += shellSize {
-		end := i + shellSize
-		if end > len(fw.particles) {
-			end = len(fw.particles)
-		}
-		indices := make([]int, end-i)
-		for j := range indices {
-			indices[j] = i + j
+	fw.shells = make([]fireworkShell, maxShells)
+	fw.freeShells = make([]int, 0, maxShells)
+	for i := len(fw.shells) - 1; i >= 0; i-- {
+		fw.shells[i].particles = make([]int, 0, fireworkShellSize)
+		fw.freeShells = append(fw.freeShells, i)
+	}
+
+	fw.launched = 0
+	fw.finale = false
+	fw.launchDelay = 0
+}
+
+// layoutText places the characters of the text centered in the upper half
+// of the screen
+func (fw *FireworksEffect) layoutText() {
+	fw.glyphs = fw.glyphs[:0]
+	if strings.TrimSpace(fw.text) == "" {
+		return
+	}
+
+	lines := strings.Split(fw.text, "\n")
+	top := max(fw.height/3-len(lines)/2, 0)
+	for i, line := range lines {
+		runes := []rune(line)
+		left := (fw.width - len(runes)) / 2
+		for j, r := range runes {
+			x, y := left+j, top+i
+			if r == ' ' || x < 0 || x >= fw.width || y >= fw.height {
+				continue
+			}
+			fw.glyphs = append(fw.glyphs, fireworkGlyph{x: x, y: y, char: r})
 		}
-		fw.shells = append(fw.shells, indices)
 	}
 }
 
@@ -86,7 +233,7 @@ func (fw *FireworksEffect) UpdatePalette(palette []string) {
 	fw.palette = palette
 }
 
-// Resize reinitializes the fireworks effect with new dimensions
+// Resize clears the sky and sizes the effect for new dimensions
 func (fw *FireworksEffect) Resize(width, height int) {
 	// Don't reinit if dimensions haven't actually changed
 	if fw.width == width && fw.height == height {
@@ -95,37 +242,114 @@ func (fw *FireworksEffect) Resize(width, height int) {
 
 	fw.width = width
 	fw.height = height
+	fw.init()
+}
 
-	// Reset animation state but don't call init() to avoid resetting all particles
-	fw.activeShells = 0
-	fw.launchDelay = 0
+// allocShell takes a shell slot and n particles from the pool, or reports
+// false if the pool or the shell slots are exhausted
+func (fw *FireworksEffect) allocShell(kind string, n int) (int, bool) {
+	if len(fw.freeShells) == 0 || len(fw.free) < n {
+		return 0, false
+	}
+	slot := fw.freeShells[len(fw.freeShells)-1]
+	fw.freeShells = fw.freeShells[:len(fw.freeShells)-1]
+
+	shell := &fw.shells[slot]
+	shell.kind = kind
+	shell.particles = shell.particles[:0]
+	shell.live = n
+	shell.explode = false
+	shell.fall = false
+	for i := 0; i < n; i++ {
+		idx := fw.free[len(fw.free)-1]
+		fw.free = fw.free[:len(fw.free)-1]
+		fw.particles[idx] = Particle{
+			alive:  true,
+			shell:  slot,
+			parent: -1,
+			char:   fireworkChars[rand.Intn(len(fireworkChars))],
+			trail:  fw.particles[idx].trail[:0], // Keep the trail's storage
+		}
+		shell.particles = append(shell.particles, idx)
+	}
+	return slot, true
+}
 
-	// Only reinit if this is a significant size change or first init
-	// (Check if we have particles - if not, this is first init)
-	if len(fw.particles) == 0 {
-		fw.init()
+// release returns a particle to the pool, and its shell once it has no
+// particles left
+func (fw *FireworksEffect) release(idx int) {
+	p := &fw.particles[idx]
+	if !p.alive {
+		return
+	}
+	p.alive = false
+	fw.free = append(fw.free, idx)
+
+	shell := &fw.shells[p.shell]
+	shell.live--
+	if shell.live == 0 {
+		if shell.kind == "text" {
+			fw.finale = false
+		}
+		fw.freeShells = append(fw.freeShells, p.shell)
 	}
 }
 
-// evaluateBezier evaluates a cubic bezier curve at parameter t
-func evaluateBezier(p0, p1, p2, p3 r2.Vec, t float64) r2.Vec {
-	it := 1 - t
-	return r2.Vec{
-		X: it*it*it*p0.X + 3*it*it*t*p1.X + 3*it*t*t*p2.X + t*t*t*p3.X,
-		Y: it*it*it*p0.Y + 3*it*it*t*p1.Y + 3*it*t*t*p2.Y + t*t*t*p3.Y,
+// launchNext launches the next shell if there's room for it. After every
+// showLength shells the text finale goes up, once the sky is clear.
+func (fw *FireworksEffect) launchNext() bool {
+	if fw.finale {
+		return false
+	}
+	// Text that can never fit in the pool is left out
+	if len(fw.glyphs) > 0 && len(fw.glyphs) <= len(fw.particles) && fw.launched >= fw.showLength {
+		if len(fw.freeShells) < len(fw.shells) {
+			return false // Wait for the sky to clear
+		}
+		slot, ok := fw.allocShell("text", len(fw.glyphs))
+		if !ok {
+			return false
+		}
+		for i, idx := range fw.shells[slot].particles {
+			p := &fw.particles[idx]
+			p.hasTarget = true
+			p.glyph = fw.glyphs[i].char
+			p.targetX, p.targetY = fw.glyphs[i].x, fw.glyphs[i].y
+		}
+		fw.launchShell(slot)
+		fw.launched = 0
+		fw.finale = true
+		return true
 	}
+
+	slot, ok := fw.allocShell(fw.pickShellType(), fireworkShellSize)
+	if !ok {
+		return false
+	}
+	fw.launchShell(slot)
+	fw.launched++
+	return true
 }
 
 // launchShell launches a group of particles
-func (fw *FireworksEffect) launchShell(shellIndex int) {
-	if shellIndex >= len(fw.shells) {
-		return
+func (fw *FireworksEffect) launchShell(slot int) {
+	indices := fw.shells[slot].particles
+	centerX := float64(rand.Intn(max(fw.width-20, 1)) + 10)           // Keep away from edges
+	centerY := float64(fw.height - 1)                                 // Start from bottom
+	explodeY := float64(rand.Intn(max(fw.height/3, 1)) + fw.height/5) // Explosion in upper third
+
+	if fw.shells[slot].kind == "text" {
+		// Burst from the middle of the text
+		centerX = float64(fw.width / 2)
+		explodeY = float64(fw.particles[indices[len(indices)/2]].targetY)
 	}
 
-	indices := fw.shells[shellIndex]
-	centerX := float64(rand.Intn(fw.width-20) + 10)           // Keep away from edges
-	centerY := float64(fw.height - 1)                         // Start from bottom
-	explodeY := float64(rand.Intn(fw.height/3) + fw.height/5) // Explosion in upper third
+	// Set initial color
+	color := "#FFFFFF"
+	if len(fw.palette) > 0 {
+		color = fw.palette[len(fw.palette)-1] // Use brightest color for launch
+	}
+	style := lipgloss.NewStyle().Foreground(lipgloss.Color(color))
 
 	for _, idx := range indices {
 		p := &fw.particles[idx]
@@ -139,23 +363,36 @@ func (fw *FireworksEffect) launchShell(shellIndex int) {
 		p.p2 = r2.Vec{X: centerX + (rand.Float64()-0.5)*2, Y: explodeY + 5}
 		p.p3 = r2.Vec{X: centerX, Y: explodeY}
 
-		// Set initial color
-		if len(fw.palette) > 0 {
-			p.color = fw.palette[len(fw.palette)-1] // Use brightest color for launch
-		} else {
-			p.color = "#FFFFFF"
-		}
-		p.style = lipgloss.NewStyle().Foreground(lipgloss.Color(p.color))
+		// Rise fast enough to stop at the explosion height
+		p.vel = r2.Vec{X: (rand.Float64() - 0.5) * 0.1, Y: -math.Sqrt(2 * fw.gravity * (centerY - explodeY))}
+
+		p.color = color
+		p.style = style
 	}
 }
 
-// explodeShell makes particles explode from their current position
-func (fw *FireworksEffect) explodeShell(shellIndex int) {
-	if shellIndex >= len(fw.shells) {
-		return
+// pickShellType picks a shell type at random by weight
+func (fw *FireworksEffect) pickShellType() string {
+	total := 0.0
+	for _, shellType := range FireworksShellTypes {
+		total += math.Max(fw.shellWeights[shellType], 0)
+	}
+	if total == 0 {
+		return FireworksShellTypes[0]
 	}
+	pick := rand.Float64() * total
+	for _, shellType := range FireworksShellTypes {
+		pick -= math.Max(fw.shellWeights[shellType], 0)
+		if pick < 0 {
+			return shellType
+		}
+	}
+	return FireworksShellTypes[len(FireworksShellTypes)-1]
+}
 
-	indices := fw.shells[shellIndex]
+// explodeShell makes particles explode from their current position
+func (fw *FireworksEffect) explodeShell(slot int) {
+	indices := fw.shells[slot].particles
 	if len(indices) == 0 {
 		return
 	}
@@ -164,41 +401,99 @@ func (fw *FireworksEffect) explodeShell(shellIndex int) {
 	centerX := fw.particles[indices[0]].pos.X
 	centerY := fw.particles[indices[0]].pos.Y
 	explodeRadius := float64(20 + rand.Intn(25)) // Larger explosion radius
+	shellColor := fw.randomColor()               // Text and splitting shells burst in one color
+	kind := fw.shells[slot].kind
+	offset := rand.Float64() * 2 * math.Pi
+
+	// Willows droop low instead of arcing up, and they and chrysanthemums
+	// leave trails
+	lift, droop := 8.0, 0.0
+	trailLen := 0
+	switch kind {
+	case "chrysanthemum":
+		trailLen = 4
+	case "willow":
+		lift, droop = 3, explodeRadius*0.3
+		trailLen = 6
+	}
 
-	for _, idx := range indices {
+	// Crossettes and multi-stage shells keep four particles per star back
+	// to split off when the star reaches the end of its curve
+	stars := len(indices)
+	if splits(kind) {
+		stars = max(len(indices)/5, 1)
+	}
+
+	for i, idx := range indices {
 		p := &fw.particles[idx]
+		if i >= stars {
+			// Waiting to split off a star
+			p.phase = 3
+			p.parent = indices[(i-stars)%stars]
+			continue
+		}
 		p.t = 0
 		p.phase = 1
-
-		// Random angle for explosion direction
-		angle := rand.Float64() * 2 * math.Pi
-		targetX := centerX + explodeRadius*math.Cos(angle)
-		targetY := centerY + explodeRadius*math.Sin(angle)*0.6 // Slightly elliptical
+		p.trail = p.trail[:0]
+		p.trailLen = trailLen
+
+		// Explosion direction and distance for the shell type
+		angle, dist := burstDirection(kind, i, stars, offset)
+		targetX := centerX + explodeRadius*dist*math.Cos(angle)
+		targetY := centerY + explodeRadius*dist*math.Sin(angle)*0.6 + droop // Slightly elliptical
+
+		// Text particles fly straight to their glyph and stay there a while
+		if p.hasTarget {
+			targetX, targetY = float64(p.targetX), float64(p.targetY)
+			p.char = p.glyph
+			p.hold = fw.textHold
+			fw.setColor(p, shellColor)
+		}
 
 		// Bezier path for explosion - arc upward then fall
 		p.p0 = r2.Vec{X: centerX, Y: centerY}
-		p.p1 = r2.Vec{X: centerX + (targetX-centerX)*0.3, Y: centerY - 8} // Stronger upward curve
-		p.p2 = r2.Vec{X: centerX + (targetX-centerX)*0.7, Y: targetY - 5} // Mid curve
+		p.p1 = r2.Vec{X: centerX + (targetX-centerX)*0.3, Y: centerY - lift} // Stronger upward curve
+		p.p2 = r2.Vec{X: centerX + (targetX-centerX)*0.7, Y: targetY - 5}    // Mid curve
 		p.p3 = r2.Vec{X: targetX, Y: targetY}
 
 		// Assign a color for this explosion
-		if len(fw.palette) > 0 {
-			p.color = fw.palette[rand.Intn(len(fw.palette))]
-			p.style = lipgloss.NewStyle().Foreground(lipgloss.Color(p.color))
+		if splits(kind) {
+			fw.setColor(p, shellColor)
+		} else if len(fw.palette) > 0 && !p.hasTarget {
+			fw.setColor(p, fw.palette[rand.Intn(len(fw.palette))])
 		}
 	}
 }
 
-// fallParticles makes particles fall to bottom of screen
-func (fw *FireworksEffect) fallParticles(shellIndex int) {
-	if shellIndex >= len(fw.shells) {
-		return
+// splits reports whether the stars of a shell type split into more stars
+// when they burn out
+func splits(kind string) bool {
+	return kind == "crossette" || kind == "multistage"
+}
+
+// burstDirection returns the angle and the share of the explosion radius
+// that star i of n flies to in a bezier shell of the given type
+func burstDirection(kind string, i, n int, offset float64) (angle, dist float64) {
+	switch kind {
+	case "chrysanthemum":
+		// Filled sphere rather than a shell
+		return rand.Float64() * 2 * math.Pi, 0.3 + 0.7*math.Sqrt(rand.Float64())
+	case "ring":
+		return offset + float64(i)*2*math.Pi/float64(n), 1
+	case "willow":
+		return rand.Float64() * 2 * math.Pi, 0.5 + 0.3*rand.Float64()
+	case "crossette", "multistage":
+		// An even ring of stars that stop short to leave room to split
+		return offset + float64(i)*2*math.Pi/float64(n), 0.5
 	}
+	return rand.Float64() * 2 * math.Pi, 1
+}
 
-	indices := fw.shells[shellIndex]
-	for _, idx := range indices {
+// fallParticles makes particles fall to bottom of screen
+func (fw *FireworksEffect) fallParticles(slot int) {
+	for _, idx := range fw.shells[slot].particles {
 		p := &fw.particles[idx]
-		if p.phase != 1 {
+		if !p.alive || p.phase != 1 {
 			continue
 		}
 
@@ -218,133 +513,339 @@ func (fw *FireworksEffect) fallParticles(shellIndex int) {
 	}
 }
 
-// Update advances the fireworks simulation
-func (fw *FireworksEffect) Update() {
-	fw.frame++
+// burstStars sets the particles of a physics shell flying from center
+func (fw *FireworksEffect) burstStars(slot int, center r2.Vec) {
+	indices := fw.shells[slot].particles
+	shellType := fw.shells[slot].kind
 
-	// Launch new shell if delay is over
-	if fw.launchDelay <= 0 && fw.activeShells < len(fw.shells) {
-		fw.launchShell(fw.activeShells)
-		fw.launchDelay = 15 + rand.Intn(20) // 15-35 frames between shells (faster)
-		fw.activeShells++
+	// Crossettes and multi-stage shells keep four particles per star back
+	// to split off when the star burns out
+	stars := len(indices)
+	if splits(shellType) {
+		stars = max(len(indices)/5, 1)
 	}
-	fw.launchDelay--
 
-	// Track which shells need phase transitions
-	shellsToExplode := make(map[int]bool)
-	shellsToFall := make(map[int]bool)
+	// Drag stops stars at about speed/drag cells, so size bursts to the screen
+	radius := math.Min(float64(fw.width)/5, float64(fw.height)*0.8) * (0.8 + 0.4*rand.Float64())
+	speed := radius * fw.drag
+	color := fw.randomColor()
+	offset := rand.Float64() * 2 * math.Pi
+	for i, idx := range indices {
+		p := &fw.particles[idx]
+		if i >= stars {
+			// Waiting to split off a star
+			p.phase = 3
+			p.parent = indices[(i-stars)%stars]
+			continue
+		}
 
-	// Update all particles
-	for i := range fw.particles {
-		p := &fw.particles[i]
+		angle := rand.Float64() * 2 * math.Pi
+		starSpeed := speed * (0.6 + 0.4*rand.Float64())
+		life := 25 + rand.Intn(10)
+		trailLen := 0
+		switch shellType {
+		case "chrysanthemum":
+			trailLen = 4
+		case "ring":
+			angle = offset + float64(i)*2*math.Pi/float64(stars)
+			starSpeed = speed
+		case "willow":
+			starSpeed *= 0.6
+			life = 55 + rand.Intn(15)
+			trailLen = 6
+		case "crossette", "multistage":
+			angle = offset + float64(i)*2*math.Pi/float64(stars)
+			starSpeed = speed * 0.8
+			life = 14 + rand.Intn(4)
+		}
+		fw.igniteStar(p, center, angle, starSpeed, life, trailLen, color)
+	}
+}
 
-		// Skip inactive particles (t=1 and phase=0 means waiting for next launch)
-		if p.t >= 1 && p.phase == 0 {
+// splitStar bursts the particles waiting on a star that burned out, and
+// returns how many there were
+func (fw *FireworksEffect) splitStar(star int) int {
+	parent := &fw.particles[star]
+	shellType := fw.shells[parent.shell].kind
+	color := parent.color
+	if shellType == "multistage" {
+		color = fw.randomColor() // Every stage in a new color
+	}
+
+	heading := math.Atan2(parent.vel.Y, parent.vel.X)
+	speed := r2.Norm(parent.vel)
+	if fw.mode != "physics" {
+		// Bezier stars head along the end of their curve
+		end := r2.Sub(parent.p3, parent.p2)
+		heading = math.Atan2(end.Y, end.X)
+	}
+
+	n := 0
+	for _, idx := range fw.shells[parent.shell].particles {
+		p := &fw.particles[idx]
+		if !p.alive || p.phase != 3 || p.parent != star {
 			continue
 		}
+		switch {
+		case fw.mode != "physics" && shellType == "crossette":
+			fw.flyStar(p, parent.pos, heading+float64(n)*math.Pi/2+math.Pi/4, 6, 2, color)
+		case fw.mode != "physics":
+			fw.flyStar(p, parent.pos, rand.Float64()*2*math.Pi, 5+rand.Float64()*4, 0, color)
+		case shellType == "crossette":
+			// Split into a cross around the star's heading
+			fw.igniteStar(p, parent.pos, heading+float64(n)*math.Pi/2+math.Pi/4, speed+0.3, 14, 2, color)
+		default:
+			fw.igniteStar(p, parent.pos, rand.Float64()*2*math.Pi, speed+0.2+rand.Float64()*0.3, 18+rand.Intn(6), 0, color)
+		}
+		n++
+	}
+	return n
+}
+
+// igniteStar sends a particle flying from pos at an angle in a given color
+func (fw *FireworksEffect) igniteStar(p *Particle, pos r2.Vec, angle, speed float64, life, trailLen int, color string) {
+	p.phase = 1
+	p.t = 0
+	p.pos = pos
+	// Cells are about twice as tall as wide
+	p.vel = r2.Vec{X: speed * math.Cos(angle), Y: speed * math.Sin(angle) * 0.5}
+	p.life = life
+	p.parent = -1
+	p.trail = p.trail[:0]
+	p.trailLen = trailLen
+	p.char = fireworkChars[rand.Intn(len(fireworkChars))]
+	fw.setColor(p, color)
+}
+
+// flyStar sends a bezier mode particle from pos along a short arc to dist
+// cells away at an angle in a given color
+func (fw *FireworksEffect) flyStar(p *Particle, pos r2.Vec, angle, dist float64, trailLen int, color string) {
+	target := r2.Vec{X: pos.X + dist*math.Cos(angle), Y: pos.Y + dist*math.Sin(angle)*0.6}
+	p.phase = 1
+	p.t = 0
+	p.pos = pos
+	p.p0 = pos
+	p.p1 = r2.Vec{X: pos.X + (target.X-pos.X)*0.3, Y: pos.Y - 2}
+	p.p2 = r2.Vec{X: pos.X + (target.X-pos.X)*0.7, Y: target.Y - 1}
+	p.p3 = target
+	p.parent = -1
+	p.trail = p.trail[:0]
+	p.trailLen = trailLen
+	p.char = fireworkChars[rand.Intn(len(fireworkChars))]
+	fw.setColor(p, color)
+}
+
+// recordTrail adds a particle's position to its trail, dropping the oldest
+// once the trail is full
+func recordTrail(p *Particle) {
+	if p.trailLen == 0 {
+		return
+	}
+	if len(p.trail) == p.trailLen {
+		copy(p.trail, p.trail[1:])
+		p.trail = p.trail[:len(p.trail)-1]
+	}
+	p.trail = append(p.trail, p.pos)
+}
+
+// setColor changes a particle's color, only building a new style when the
+// color actually changes
+func (fw *FireworksEffect) setColor(p *Particle, color string) {
+	if p.color == color {
+		return
+	}
+	p.color = color
+	p.style = lipgloss.NewStyle().Foreground(lipgloss.Color(color))
+}
+
+// randomColor returns a random palette color
+func (fw *FireworksEffect) randomColor() string {
+	if len(fw.palette) == 0 {
+		return "#FFFFFF"
+	}
+	return fw.palette[rand.Intn(len(fw.palette))]
+}
+
+// stepPhysics moves a physics mode particle by its velocity and applies
+// gravity and drag, bursting rockets at their peak and splitting stars that
+// burn out
+func (fw *FireworksEffect) stepPhysics(idx int) {
+	p := &fw.particles[idx]
+	switch p.phase {
+	case 0:
+		// Rockets rise without drag and burst as they start to fall
+		p.pos = r2.Add(p.pos, p.vel)
+		p.vel.Y += fw.gravity
+		if p.vel.Y >= 0 {
+			fw.burstStars(p.shell, p.pos)
+		}
+
+	case 1:
+		recordTrail(p)
+		p.pos = r2.Add(p.pos, p.vel)
+		p.vel = r2.Scale(1-fw.drag, p.vel)
+		p.vel.Y += fw.gravity
+		p.life--
+
+		// Burning out
+		if p.life <= 0 {
+			fw.splitStar(idx)
+			fw.release(idx)
+		} else if p.life < 5 {
+			p.char = '.'
+		}
+	}
+}
+
+// stepBezier moves a particle along its curve, flagging its shell for the
+// next phase once it reaches the end
+func (fw *FireworksEffect) stepBezier(idx int) {
+	p := &fw.particles[idx]
+	if p.phase == 3 {
+		return // Waiting to split off a star
+	}
+
+	// Different speeds for different phases
+	speed := 0.03 // Default speed
+	switch p.phase {
+	case 0: // Launch - faster
+		speed = 0.05
+	case 1: // Explosion - medium
+		speed = 0.03
+	case 2: // Fall - faster
+		speed = 0.04
+	}
+
+	p.t += speed
+	if p.phase != 0 {
+		recordTrail(p)
+	}
 
-		// Different speeds for different phases
-		speed := 0.03 // Default speed
+	// Update position along bezier path
+	if p.t <= 1 {
+		p.pos = motion.CubicBezier(p.p0, p.p1, p.p2, p.p3, p.t)
+	}
+
+	// Handle phase transitions
+	if p.t >= 1 {
+		p.t = 1
+		if p.hasTarget && p.phase == 1 {
+			p.pos = p.p3 // Land exactly on the glyph's cell
+		}
+		shell := &fw.shells[p.shell]
 		switch p.phase {
-		case 0: // Launch - faster
-			speed = 0.05
-		case 1: // Explosion - medium
-			speed = 0.03
-		case 2: // Fall - faster
-			speed = 0.04
-		}
-
-		p.t += speed
-
-		// Update position along bezier path
-		if p.t <= 1 {
-			p.pos = evaluateBezier(p.p0, p.p1, p.p2, p.p3, p.t)
-		}
-
-		// Handle phase transitions
-		if p.t >= 1 {
-			p.t = 1
-			// Find which shell this particle belongs to
-			for shellIdx, indices := range fw.shells {
-				for _, idx := range indices {
-					if idx == i {
-						switch p.phase {
-						case 0: // Launch complete, mark for explosion
-							shellsToExplode[shellIdx] = true
-						case 1: // Explosion complete, mark for fall
-							shellsToFall[shellIdx] = true
-						case 2: // Fall complete, hide particle
-							p.t = 1 // Keep at end so it doesn't render
-							p.phase = 0
-							p.pos = r2.Vec{X: -100, Y: -100} // Move off-screen
-						}
-						break
-					}
-				}
+		case 0: // Launch complete, mark for explosion
+			shell.explode = true
+		case 1: // Explosion complete, split or mark for fall
+			if splits(shell.kind) && fw.splitStar(idx) > 0 {
+				fw.release(idx)
+				return
 			}
+			if p.hold > 0 {
+				p.hold-- // Text stays up a while first
+				break
+			}
+			shell.fall = true
+		case 2: // Fall complete, back to the pool
+			fw.release(idx)
+			return
 		}
+	}
 
-		// Update color based on phase
-		if len(fw.palette) > 0 {
-			switch p.phase {
-			case 0: // Launch - bright color
-				p.color = fw.palette[len(fw.palette)-1] // Brightest
-			case 1: // Explosion - random color
-				if p.t < 0.1 || rand.Float64() < 0.05 { // Change color occasionally
-					p.color = fw.palette[rand.Intn(len(fw.palette))]
-				}
-			case 2: // Fall - fade to darker colors
-				fadeIdx := int(p.t * float64(len(fw.palette)-1))
-				if fadeIdx >= len(fw.palette) {
-					fadeIdx = len(fw.palette) - 1
-				}
-				p.color = fw.palette[fadeIdx]
+	// Update color based on phase
+	if len(fw.palette) > 0 {
+		switch p.phase {
+		case 0: // Launch - bright color
+			fw.setColor(p, fw.palette[len(fw.palette)-1]) // Brightest
+		case 1: // Explosion - random color, except stages of splitting shells
+			if !p.hasTarget && !splits(fw.shells[p.shell].kind) && (p.t < 0.1 || rand.Float64() < 0.05) { // Change color occasionally
+				fw.setColor(p, fw.palette[rand.Intn(len(fw.palette))])
+			}
+		case 2: // Fall - fade to darker colors
+			fadeIdx := int(p.t * float64(len(fw.palette)-1))
+			if fadeIdx >= len(fw.palette) {
+				fadeIdx = len(fw.palette) - 1
 			}
-			p.style = lipgloss.NewStyle().Foreground(lipgloss.Color(p.color))
+			fw.setColor(p, fw.palette[fadeIdx])
 		}
 	}
+}
 
-	// Execute phase transitions for shells
-	for shellIdx := range shellsToExplode {
-		fw.explodeShell(shellIdx)
+// Update advances the fireworks simulation
+func (fw *FireworksEffect) Update() {
+	fw.frame++
+
+	// Launch new shell if delay is over, more often on wider screens
+	if fw.launchDelay <= 0 && fw.launchNext() {
+		fw.launchDelay = (15 + rand.Intn(20)) * 80 / max(fw.width, 80) // 15-35 frames between shells at 80 columns
 	}
-	for shellIdx := range shellsToFall {
-		fw.fallParticles(shellIdx)
+	fw.launchDelay--
+
+	// Update all particles in use. Text bursts follow curves to their glyphs
+	// in physics mode too.
+	for i := range fw.particles {
+		p := &fw.particles[i]
+		if !p.alive {
+			continue
+		}
+		if fw.mode == "physics" && !p.hasTarget {
+			fw.stepPhysics(i)
+		} else {
+			fw.stepBezier(i)
+		}
 	}
 
-	// Reset when all shells have been launched and completed
-	if fw.activeShells >= len(fw.shells) {
-		allComplete := true
-		for i := range fw.particles {
-			if fw.particles[i].phase != 0 || fw.particles[i].t < 1 {
-				allComplete = false
-				break
-			}
+	// Execute phase transitions for shells
+	for slot := range fw.shells {
+		shell := &fw.shells[slot]
+		if shell.explode {
+			shell.explode = false
+			fw.explodeShell(slot)
 		}
-		if allComplete {
-			fw.activeShells = 0
-			fw.launchDelay = 0
+		if shell.fall {
+			shell.fall = false
+			fw.fallParticles(slot)
 		}
 	}
 }
 
 // Render converts the fireworks to colored text output
 func (fw *FireworksEffect) Render() string {
-	// Create empty canvas
-	canvas := make([][]rune, fw.height)
-	styles := make([][]lipgloss.Style, fw.height)
+	// Reuse the canvas between frames
+	if len(fw.canvas) != fw.height || fw.height > 0 && len(fw.canvas[0]) != fw.width {
+		fw.canvas = make([][]rune, fw.height)
+		fw.styles = make([][]lipgloss.Style, fw.height)
+		for i := range fw.canvas {
+			fw.canvas[i] = make([]rune, fw.width)
+			fw.styles[i] = make([]lipgloss.Style, fw.width)
+		}
+	}
+	canvas, styles := fw.canvas, fw.styles
 	for i := range canvas {
-		canvas[i] = make([]rune, fw.width)
-		styles[i] = make([]lipgloss.Style, fw.width)
 		for j := range canvas[i] {
 			canvas[i][j] = ' '
 		}
 	}
 
-	// Place particles on canvas
-	for _, p := range fw.particles {
-		// Only render particles that are actively animating
-		if p.t >= 1 {
+	// Trails go under the stars
+	for i := range fw.particles {
+		p := &fw.particles[i]
+		if !p.alive {
+			continue
+		}
+		for _, pos := range p.trail {
+			x, y := int(pos.X), int(pos.Y)
+			if x >= 0 && x < fw.width && y >= 0 && y < fw.height {
+				canvas[y][x] = '·'
+				styles[y][x] = p.style
+			}
+		}
+	}
+
+	// Place particles on canvas, except those waiting to split off a star
+	for i := range fw.particles {
+		p := &fw.particles[i]
+		if !p.alive || p.phase == 3 {
 			continue
 		}
 		x, y := int(p.pos.X), int(p.pos.Y)
@@ -355,19 +856,21 @@ func (fw *FireworksEffect) Render() string {
 	}
 
 	// Convert to colored string
-	var lines []string
+	var out strings.Builder
+	out.Grow(fw.width * fw.height)
 	for y := 0; y < fw.height; y++ {
-		var line strings.Builder
+		if y > 0 {
+			out.WriteByte('\n')
+		}
 		for x := 0; x < fw.width; x++ {
 			char := canvas[y][x]
 			if char != ' ' {
-				line.WriteString(styles[y][x].Render(string(char)))
+				out.WriteString(styles[y][x].Render(string(char)))
 			} else {
-				line.WriteRune(char)
+				out.WriteRune(char)
 			}
 		}
-		lines = append(lines, line.String())
 	}
 
-	return strings.Join(lines, "\n")
+	return out.String()
 }
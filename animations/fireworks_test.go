@@ -0,0 +1,110 @@
+package animations
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+)
+
+// benchmarkFireworks times a frame of fireworks, Update followed by Render,
+// at a regular and a large terminal size. Besides the mean it reports the
+// median, 99th percentile and slowest frame, which stay close together while
+// the particle pool keeps frame times stable.
+func benchmarkFireworks(b *testing.B, mode, text string) {
+	for _, size := range [][2]int{{80, 24}, {300, 100}} {
+		b.Run(fmt.Sprintf("%dx%d", size[0], size[1]), func(b *testing.B) {
+			fw := NewFireworksEffectWithConfig(FireworksConfig{
+				Width:   size[0],
+				Height:  size[1],
+				Palette: GetFireworksPalette("dracula"),
+				Mode:    mode,
+				Text:    text,
+			})
+
+			// Warm up until the sky is busy
+			for i := 0; i < 500; i++ {
+				fw.Update()
+			}
+
+			frames := make([]time.Duration, b.N)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := range frames {
+				start := time.Now()
+				fw.Update()
+				fw.Render()
+				frames[i] = time.Since(start)
+			}
+			b.StopTimer()
+
+			slices.Sort(frames)
+			b.ReportMetric(float64(frames[len(frames)/2]), "p50-ns/frame")
+			b.ReportMetric(float64(frames[len(frames)*99/100]), "p99-ns/frame")
+			b.ReportMetric(float64(frames[len(frames)-1]), "max-ns/frame")
+		})
+	}
+}
+
+func BenchmarkFireworksBezier(b *testing.B) {
+	benchmarkFireworks(b, "bezier", "")
+}
+
+func BenchmarkFireworksPhysics(b *testing.B) {
+	benchmarkFireworks(b, "physics", "")
+}
+
+func BenchmarkFireworksText(b *testing.B) {
+	benchmarkFireworks(b, "bezier", "SYSC-GO\nFIREWORKS")
+}
+
+func TestFireworksShellTypesInBothModes(t *testing.T) {
+	for _, mode := range []string{"bezier", "physics"} {
+		t.Run(mode, func(t *testing.T) {
+			for _, kind := range []string{"crossette", "multistage"} {
+				fw := NewFireworksEffectWithConfig(FireworksConfig{
+					Width:        80,
+					Height:       24,
+					Palette:      GetFireworksPalette("dracula"),
+					Mode:         mode,
+					ShellWeights: map[string]float64{kind: 1},
+				})
+
+				// Particles held back on a star fly once it splits
+				waiting := make([]bool, len(fw.particles))
+				split := false
+				for frame := 0; frame < 500 && !split; frame++ {
+					fw.Update()
+					for i, p := range fw.particles {
+						split = split || waiting[i] && p.alive && p.phase == 1
+						waiting[i] = p.alive && p.phase == 3
+					}
+				}
+				if !split {
+					t.Errorf("%s stars never split", kind)
+				}
+			}
+
+			for _, kind := range []string{"chrysanthemum", "willow"} {
+				fw := NewFireworksEffectWithConfig(FireworksConfig{
+					Width:        80,
+					Height:       24,
+					Palette:      GetFireworksPalette("dracula"),
+					Mode:         mode,
+					ShellWeights: map[string]float64{kind: 1},
+				})
+
+				trailed := false
+				for frame := 0; frame < 500 && !trailed; frame++ {
+					fw.Update()
+					for _, p := range fw.particles {
+						trailed = trailed || p.alive && len(p.trail) > 0
+					}
+				}
+				if !trailed {
+					t.Errorf("%s stars never left a trail", kind)
+				}
+			}
+		})
+	}
+}
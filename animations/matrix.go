@@ -14,11 +14,56 @@ type MatrixEffect struct {
 	palette []string // Theme color palette
 	chars   []rune   // Matrix characters
 
+	flicker   float64 // Chance per frame that a trail glyph changes
+	headGlyph rune    // Glyph of every streak head, 0 = a random glyph each frame
+
+	density     float64 // Chance per column and frame of a new streak
+	speedRange  [2]int  // Frames per cell, fastest to slowest
+	lengthRange [2]int  // Streak lengths, shortest to longest
+
+	// Message mode - streaks lock characters into place as they pass
+	message [][]rune // Hidden text lines
+	locked  [][]bool // Message characters already revealed
+
 	// Particle-based implementation - individual streaks that move down screen
 	streaks []MatrixStreak // Active streaks
 	frame   int            // Animation frame counter
 }
 
+// MatrixConfig holds configuration for the Matrix effect
+type MatrixConfig struct {
+	Width   int
+	Height  int
+	Palette []string // Colors from dimmest to brightest
+
+	GlyphSet    string  // "classic" (default), "katakana", "binary" or "hex"
+	Glyphs      []rune  // Custom glyphs, replacing GlyphSet when set
+	Density     float64 // Chance per column and frame that a new streak starts, default 0.02
+	SpeedRange  [2]int  // Frames a streak takes to move one cell, default 1-3
+	LengthRange [2]int  // Streak length in cells, default 5-20
+	Flicker     float64 // Chance per frame that a trail glyph changes, default 0.05
+	HeadGlyph   rune    // Glyph drawn at every streak head, default a random glyph each frame
+
+	// Message is revealed in the middle of the screen, one character at a
+	// time as streaks pass over it, like the film's title sequence
+	Message string
+}
+
+// matrixGlyphSets are the glyph sets selectable with MatrixConfig.GlyphSet
+var matrixGlyphSets = map[string][]rune{
+	// A mix of Latin, Greek, Cyrillic and block characters
+	"classic": []rune("0123456789" +
+		"ABCDEFGHIJKLMNOPQRSTUVWXYZ" +
+		"abcdefghijklmnopqrstuvwxyz" +
+		"αβγδεζηθικλμνξοπρστυφχψω" +
+		"АБВГДЕЖЗИЙКЛМНОПРСТУФХЦЧШЩ" +
+		"░▒▓█▀▄▌▐■□▪▫"),
+	// Half-width katakana, as in the film, with a few digits
+	"katakana": []rune("ｦｧｨｩｪｫｬｭｮｯｰｱｲｳｴｵｶｷｸｹｺｻｼｽｾｿﾀﾁﾂﾃﾄﾅﾆﾇﾈﾉﾊﾋﾌﾍﾎﾏﾐﾑﾒﾓﾔﾕﾖﾗﾘﾙﾚﾛﾜﾝ0123456789"),
+	"binary":   []rune("01"),
+	"hex":      []rune("0123456789ABCDEF"),
+}
+
 // MatrixStreak represents a single vertical streak falling down the screen
 type MatrixStreak struct {
 	X       int  // X position (column)
@@ -27,6 +72,10 @@ type MatrixStreak struct {
 	Speed   int  // Movement speed (frames per pixel)
 	Counter int  // Frame counter for movement
 	Active  bool // Whether streak is active
+
+	// Glyphs holds the character of every cell from the head up the trail.
+	// Cells keep their glyph as the streak moves on, unless they flicker.
+	Glyphs []rune
 }
 
 // MatrixChar represents a single character in a streak
@@ -37,44 +86,97 @@ type MatrixChar struct {
 
 // NewMatrixEffect creates a new Matrix effect with given dimensions and theme palette
 func NewMatrixEffect(width, height int, palette []string) *MatrixEffect {
+	return NewMatrixEffectWithConfig(MatrixConfig{
+		Width:   width,
+		Height:  height,
+		Palette: palette,
+	})
+}
+
+// NewMatrixEffectWithConfig creates a Matrix effect with custom glyphs, streak
+// density and speeds, and an optional hidden message
+func NewMatrixEffectWithConfig(config MatrixConfig) *MatrixEffect {
+	glyphs := config.Glyphs
+	if len(glyphs) == 0 {
+		glyphs = matrixGlyphSets[config.GlyphSet]
+	}
+	if len(glyphs) == 0 {
+		glyphs = matrixGlyphSets["classic"]
+	}
+	if config.Density == 0 {
+		config.Density = 0.02
+	}
+	if config.SpeedRange[0] == 0 {
+		config.SpeedRange = [2]int{1, 3}
+	}
+	if config.LengthRange[0] == 0 {
+		config.LengthRange = [2]int{5, 20}
+	}
+	if config.Flicker == 0 {
+		config.Flicker = 0.05
+	}
+
 	m := &MatrixEffect{
-		width:   width,
-		height:  height,
-		palette: palette,
-		// Use a mix of Latin, Greek, and Japanese characters like the original Matrix effect
-		chars: []rune{
-			'0', '1', '2', '3', '4', '5', '6', '7', '8', '9',
-			'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M',
-			'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z',
-			'a', 'b', 'c', 'd', 'e', 'f', 'g', 'h', 'i', 'j', 'k', 'l', 'm',
-			'n', 'o', 'p', 'q', 'r', 's', 't', 'u', 'v', 'w', 'x', 'y', 'z',
-			'α', 'β', 'γ', 'δ', 'ε', 'ζ', 'η', 'θ', 'ι', 'κ', 'λ', 'μ',
-			'ν', 'ξ', 'ο', 'π', 'ρ', 'σ', 'τ', 'υ', 'φ', 'χ', 'ψ', 'ω',
-			'А', 'Б', 'В', 'Г', 'Д', 'Е', 'Ж', 'З', 'И', 'Й', 'К', 'Л', 'М',
-			'Н', 'О', 'П', 'Р', 'С', 'Т', 'У', 'Ф', 'Х', 'Ц', 'Ч', 'Ш', 'Щ',
-			'░', '▒', '▓', '█', '▀', '▄', '▌', '▐', '■', '□', '▪', '▫',
-		},
-		streaks: make([]MatrixStreak, 0, 100), // Pre-allocate capacity
-		frame:   0,
+		width:       config.Width,
+		height:      config.Height,
+		palette:     config.Palette,
+		chars:       glyphs,
+		density:     config.Density,
+		speedRange:  config.SpeedRange,
+		lengthRange: config.LengthRange,
+		flicker:     config.Flicker,
+		headGlyph:   config.HeadGlyph,
+		streaks:     make([]MatrixStreak, 0, 100), // Pre-allocate capacity
+		frame:       0,
+	}
+
+	if strings.TrimSpace(config.Message) != "" {
+		for _, line := range strings.Split(config.Message, "\n") {
+			m.message = append(m.message, []rune(line))
+			m.locked = append(m.locked, make([]bool, len([]rune(line))))
+		}
 	}
+
 	m.init()
 	return m
 }
 
+// randomInRange returns a random value from an inclusive range
+func randomInRange(r [2]int) int {
+	if r[1] <= r[0] {
+		return r[0]
+	}
+	return r[0] + rand.Intn(r[1]-r[0]+1)
+}
+
+// newStreak creates a streak in column x with its head at row y
+func (m *MatrixEffect) newStreak(x, y int) MatrixStreak {
+	streak := MatrixStreak{
+		X:       x,
+		Y:       y,
+		Length:  randomInRange(m.lengthRange),
+		Speed:   randomInRange(m.speedRange),
+		Counter: 0,
+		Active:  true,
+	}
+	streak.Glyphs = make([]rune, streak.Length)
+	for i := range streak.Glyphs {
+		streak.Glyphs[i] = m.randomGlyph()
+	}
+	return streak
+}
+
+// randomGlyph returns a random character from the glyph set
+func (m *MatrixEffect) randomGlyph() rune {
+	return m.chars[rand.Intn(len(m.chars))]
+}
+
 // Initialize Matrix effect with some initial streaks
 func (m *MatrixEffect) init() {
 	// Create initial streaks across width
 	for i := 0; i < m.width; i++ {
-		if rand.Float64() < 0.1 { // 10% chance of initial streak
-			streak := MatrixStreak{
-				X:       i,
-				Y:       -rand.Intn(m.height), // Start above screen
-				Length:  rand.Intn(15) + 5,    // Length 5-20
-				Speed:   rand.Intn(3) + 1,     // Speed 1-3
-				Counter: 0,
-				Active:  true,
-			}
-			m.streaks = append(m.streaks, streak)
+		if rand.Float64() < m.density*5 { // 10% chance of initial streak at the default density
+			m.streaks = append(m.streaks, m.newStreak(i, -rand.Intn(max(m.height, 1)))) // Start above screen
 		}
 	}
 }
@@ -163,6 +265,18 @@ func (m *MatrixEffect) Update() {
 				streak.Active = false
 				continue
 			}
+
+			// The trail stays where it was written and the head moves on
+			copy(streak.Glyphs[1:], streak.Glyphs)
+			streak.Glyphs[0] = m.randomGlyph()
+		}
+
+		// Heads cycle through glyphs every frame, trail glyphs only flicker
+		streak.Glyphs[0] = m.randomGlyph()
+		for i := 1; i < len(streak.Glyphs); i++ {
+			if rand.Float64() < m.flicker {
+				streak.Glyphs[i] = m.randomGlyph()
+			}
 		}
 
 		// Add updated streak to active list
@@ -175,28 +289,99 @@ func (m *MatrixEffect) Update() {
 	// Add new streaks randomly
 	for i := 0; i < m.width; i++ {
 		// Low probability to create new streaks
-		if rand.Float64() < 0.02 && len(m.streaks) < 150 { // Limit total streaks
-			streak := MatrixStreak{
-				X:       i,
-				Y:       -rand.Intn(5),     // Start just above screen
-				Length:  rand.Intn(15) + 5, // Length 5-20
-				Speed:   rand.Intn(3) + 1,  // Speed 1-3
-				Counter: 0,
-				Active:  true,
+		if rand.Float64() < m.density && len(m.streaks) < 150 { // Limit total streaks
+			m.streaks = append(m.streaks, m.newStreak(i, -rand.Intn(5))) // Start just above screen
+		}
+	}
+
+	m.revealMessage()
+}
+
+// messageOrigin returns the screen position of the first character of a
+// message line, centered on screen
+func (m *MatrixEffect) messageOrigin(line int) (x, y int) {
+	x = (m.width - len(m.message[line])) / 2
+	y = (m.height-len(m.message))/2 + line
+	return x, y
+}
+
+// messageVisible reports whether a message character falls on screen. Runes
+// of a message wider or taller than the screen are never shown or revealed.
+func (m *MatrixEffect) messageVisible(line, col int) bool {
+	left, top := m.messageOrigin(line)
+	x := left + col
+	return top >= 0 && top < m.height && x >= 0 && x < m.width
+}
+
+// messageAt returns the message line and column shown at a screen cell
+func (m *MatrixEffect) messageAt(x, y int) (line, col int, ok bool) {
+	if len(m.message) == 0 {
+		return 0, 0, false
+	}
+	_, top := m.messageOrigin(0)
+	line = y - top
+	if line < 0 || line >= len(m.message) {
+		return 0, 0, false
+	}
+	left, _ := m.messageOrigin(line)
+	col = x - left
+	if col < 0 || col >= len(m.message[line]) || m.message[line][col] == ' ' {
+		return 0, 0, false
+	}
+	return line, col, true
+}
+
+// revealMessage locks message characters under streak heads and sends extra
+// streaks down columns that still have hidden characters
+func (m *MatrixEffect) revealMessage() {
+	if len(m.message) == 0 {
+		return
+	}
+
+	for _, streak := range m.streaks {
+		line, col, ok := m.messageAt(streak.X, streak.Y)
+		if ok && streak.Counter == 0 && rand.Float64() < 0.5 {
+			m.locked[line][col] = true
+		}
+	}
+
+	for line, runes := range m.message {
+		left, _ := m.messageOrigin(line)
+		for col := range runes {
+			if runes[col] != ' ' && !m.locked[line][col] && m.messageVisible(line, col) && rand.Float64() < m.density {
+				m.streaks = append(m.streaks, m.newStreak(left+col, -rand.Intn(5)))
 			}
-			m.streaks = append(m.streaks, streak)
 		}
 	}
 }
 
-// Render converts the Matrix streaks to colored text output
+// IsComplete reports whether the visible part of the message has been
+// revealed. It is always false without a message, as the rain never ends.
+func (m *MatrixEffect) IsComplete() bool {
+	if len(m.message) == 0 {
+		return false
+	}
+	for line, runes := range m.message {
+		for col, r := range runes {
+			if r != ' ' && !m.locked[line][col] && m.messageVisible(line, col) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Render converts the Matrix streaks to colored text output. It only reads
+// the effect's state, so rendering the same frame twice gives the same output.
 func (m *MatrixEffect) Render() string {
 	// Create empty canvas
 	canvas := make([][]rune, m.height)
 	colors := make([][]string, m.height)
+	glow := make([][]bool, m.height)
 	for i := range canvas {
 		canvas[i] = make([]rune, m.width)
 		colors[i] = make([]string, m.width)
+		glow[i] = make([]bool, m.width)
 		for j := range canvas[i] {
 			canvas[i][j] = ' '
 			colors[i][j] = ""
@@ -209,18 +394,21 @@ func (m *MatrixEffect) Render() string {
 			continue
 		}
 
-		// Render the streak - from head downward
+		// Render the streak - from head upward
 		for i := 0; i < streak.Length; i++ {
-			yPos := streak.Y + i // Head at streak.Y, trail going down
+			yPos := streak.Y - i // Head at streak.Y, trail above it
 			if yPos >= 0 && yPos < m.height && streak.X >= 0 && streak.X < m.width {
 				// Get character
-				char := m.chars[rand.Intn(len(m.chars))]
+				char := streak.Glyphs[i]
 
 				// Get color based on position in streak
 				var color string
 				if i == 0 {
 					// Head is brightest
 					color = m.getHeadColor()
+					if m.headGlyph != 0 {
+						char = m.headGlyph
+					}
 				} else {
 					// Trail fades
 					color = m.getTrailColor(i, streak.Length)
@@ -229,6 +417,20 @@ func (m *MatrixEffect) Render() string {
 				// Place character on canvas
 				canvas[yPos][streak.X] = char
 				colors[yPos][streak.X] = color
+				glow[yPos][streak.X] = i == 0
+			}
+		}
+	}
+
+	// Revealed message characters glow on top of the rain
+	for line, runes := range m.message {
+		left, top := m.messageOrigin(line)
+		for col, r := range runes {
+			x := left + col
+			if m.locked[line][col] && top >= 0 && top < m.height && x >= 0 && x < m.width {
+				canvas[top][x] = r
+				colors[top][x] = m.getHeadColor()
+				glow[top][x] = true
 			}
 		}
 	}
@@ -243,6 +445,7 @@ func (m *MatrixEffect) Render() string {
 				// Render colored character
 				styled := lipgloss.NewStyle().
 					Foreground(lipgloss.Color(colors[y][x])).
+					Bold(glow[y][x]).
 					Render(string(char))
 				line.WriteString(styled)
 			} else {
@@ -259,5 +462,8 @@ func (m *MatrixEffect) Render() string {
 func (m *MatrixEffect) Reset() {
 	m.frame = 0
 	m.streaks = m.streaks[:0]
+	for _, locked := range m.locked {
+		clear(locked)
+	}
 	m.init()
 }
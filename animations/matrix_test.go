@@ -0,0 +1,38 @@
+package animations
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMatrixMessageLargerThanScreenCompletes(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+	}{
+		{"fits", "WAKE UP"},
+		{"too wide", strings.Repeat("FOLLOW THE WHITE RABBIT ", 4)},
+		{"too tall", strings.Repeat("KNOCK\n", 30)},
+		{"too wide and tall", strings.Repeat(strings.Repeat("NEO ", 20)+"\n", 30)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMatrixEffectWithConfig(MatrixConfig{
+				Width:   40,
+				Height:  10,
+				Palette: []string{"#00ff00"},
+				Message: tt.message,
+			})
+			for frame := 0; frame < 5000 && !m.IsComplete(); frame++ {
+				m.Update()
+			}
+			if !m.IsComplete() {
+				t.Fatal("message never completed")
+			}
+			if lines := strings.Split(m.Render(), "\n"); len(lines) != 10 {
+				t.Fatalf("rendered %d lines, want 10", len(lines))
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package motion
+
+import "math"
+
+// Easing maps linear progress from 0 to 1 to eased progress. Eased progress
+// starts at 0 and ends at 1, but elastic and back easings overshoot in between.
+type Easing func(t float64) float64
+
+// Easings holds every easing function by name
+var Easings = map[string]Easing{
+	"linear":       Linear,
+	"in-quad":      InQuad,
+	"out-quad":     OutQuad,
+	"in-out-quad":  InOutQuad,
+	"in-cubic":     InCubic,
+	"out-cubic":    OutCubic,
+	"in-out-cubic": InOutCubic,
+	"in-bounce":    InBounce,
+	"out-bounce":   OutBounce,
+	"in-elastic":   InElastic,
+	"out-elastic":  OutElastic,
+	"in-back":      InBack,
+	"out-back":     OutBack,
+}
+
+// EasingNames lists the names in Easings in a stable order
+var EasingNames = []string{
+	"linear",
+	"in-quad", "out-quad", "in-out-quad",
+	"in-cubic", "out-cubic", "in-out-cubic",
+	"in-bounce", "out-bounce",
+	"in-elastic", "out-elastic",
+	"in-back", "out-back",
+}
+
+// Linear doesn't ease at all
+func Linear(t float64) float64 {
+	return t
+}
+
+// InQuad starts slow and speeds up
+func InQuad(t float64) float64 {
+	return t * t
+}
+
+// OutQuad starts fast and slows down
+func OutQuad(t float64) float64 {
+	return 1 - (1-t)*(1-t)
+}
+
+// InOutQuad speeds up, then slows down
+func InOutQuad(t float64) float64 {
+	if t < 0.5 {
+		return 2 * t * t
+	}
+	return 1 - math.Pow(-2*t+2, 2)/2
+}
+
+// InCubic starts slower than InQuad and speeds up harder
+func InCubic(t float64) float64 {
+	return t * t * t
+}
+
+// OutCubic starts fast and slows down harder than OutQuad
+func OutCubic(t float64) float64 {
+	return 1 - math.Pow(1-t, 3)
+}
+
+// InOutCubic speeds up, then slows down, more sharply than InOutQuad
+func InOutCubic(t float64) float64 {
+	if t < 0.5 {
+		return 4 * t * t * t
+	}
+	return 1 - math.Pow(-2*t+2, 3)/2
+}
+
+// OutBounce falls and bounces to rest like a dropped ball
+func OutBounce(t float64) float64 {
+	const n1 = 7.5625
+	const d1 = 2.75
+	switch {
+	case t < 1/d1:
+		return n1 * t * t
+	case t < 2/d1:
+		t -= 1.5 / d1
+		return n1*t*t + 0.75
+	case t < 2.5/d1:
+		t -= 2.25 / d1
+		return n1*t*t + 0.9375
+	default:
+		t -= 2.625 / d1
+		return n1*t*t + 0.984375
+	}
+}
+
+// InBounce bounces with growing height before taking off
+func InBounce(t float64) float64 {
+	return 1 - OutBounce(1-t)
+}
+
+// OutElastic overshoots and springs back and forth before settling
+func OutElastic(t float64) float64 {
+	if t <= 0 || t >= 1 {
+		return t
+	}
+	return math.Pow(2, -10*t)*math.Sin((t*10-0.75)*2*math.Pi/3) + 1
+}
+
+// InElastic winds up back and forth before springing away
+func InElastic(t float64) float64 {
+	if t <= 0 || t >= 1 {
+		return t
+	}
+	return -math.Pow(2, 10*t-10) * math.Sin((t*10-10.75)*2*math.Pi/3)
+}
+
+// backOvershoot is how far the back easings overshoot, about 10%
+const backOvershoot = 1.70158
+
+// OutBack overshoots the end and pulls back
+func OutBack(t float64) float64 {
+	return 1 + (backOvershoot+1)*math.Pow(t-1, 3) + backOvershoot*math.Pow(t-1, 2)
+}
+
+// InBack pulls back before moving forward
+func InBack(t float64) float64 {
+	return (backOvershoot+1)*t*t*t - backOvershoot*t*t
+}
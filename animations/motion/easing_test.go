@@ -0,0 +1,45 @@
+package motion
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEasingEndpoints(t *testing.T) {
+	if len(Easings) != len(EasingNames) {
+		t.Fatalf("%d easings but %d names", len(Easings), len(EasingNames))
+	}
+	for _, name := range EasingNames {
+		easing, ok := Easings[name]
+		if !ok {
+			t.Errorf("%s: listed in EasingNames but missing from Easings", name)
+			continue
+		}
+		if got := easing(0); math.Abs(got) > 1e-9 {
+			t.Errorf("%s(0) = %v, want 0", name, got)
+		}
+		if got := easing(1); math.Abs(got-1) > 1e-9 {
+			t.Errorf("%s(1) = %v, want 1", name, got)
+		}
+	}
+}
+
+func TestTweenReachesTarget(t *testing.T) {
+	tween := NewTween(10, 50, 8, OutBounce)
+	steps := 0
+	for !tween.Done() {
+		tween.Step()
+		steps++
+	}
+	if steps != 8 {
+		t.Errorf("took %d steps, want 8", steps)
+	}
+	if got := tween.Value(); got != 50 {
+		t.Errorf("final value %v, want 50", got)
+	}
+
+	tween.Reset()
+	if got := tween.Value(); got != 10 {
+		t.Errorf("value after Reset %v, want 10", got)
+	}
+}
@@ -0,0 +1,270 @@
+package motion
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ColorSpace selects how a Gradient blends between its stops
+type ColorSpace int
+
+const (
+	RGB   ColorSpace = iota // Straight blend of red, green and blue
+	HSL                     // Around the color wheel the short way, keeping colors saturated
+	OKLab                   // Perceptually even steps in lightness and hue
+)
+
+// String returns the name of the color space
+func (s ColorSpace) String() string {
+	switch s {
+	case RGB:
+		return "rgb"
+	case HSL:
+		return "hsl"
+	case OKLab:
+		return "oklab"
+	}
+	return fmt.Sprintf("ColorSpace(%d)", int(s))
+}
+
+// ParseColorSpace looks up a color space by name: "rgb", "hsl" or "oklab"
+func ParseColorSpace(name string) (ColorSpace, bool) {
+	switch strings.ToLower(name) {
+	case "rgb":
+		return RGB, true
+	case "hsl":
+		return HSL, true
+	case "oklab":
+		return OKLab, true
+	}
+	return RGB, false
+}
+
+// rgb is a color with channels from 0 to 1
+type rgb struct {
+	r, g, b float64
+}
+
+// Gradient blends smoothly through any number of hex color stops, spaced
+// evenly from 0 to 1. The zero Gradient is white everywhere.
+type Gradient struct {
+	stops []rgb
+	space ColorSpace
+}
+
+// NewGradient creates an RGB gradient through hex color stops like
+// "#ff79c6". Invalid stops are white.
+func NewGradient(stops ...string) Gradient {
+	g := Gradient{stops: make([]rgb, len(stops))}
+	for i, stop := range stops {
+		g.stops[i] = parseHex(stop)
+	}
+	return g
+}
+
+// In returns the gradient blending in another color space
+func (g Gradient) In(space ColorSpace) Gradient {
+	g.space = space
+	return g
+}
+
+// Space returns the color space the gradient blends in
+func (g Gradient) Space() ColorSpace {
+	return g.space
+}
+
+// Len returns the number of stops
+func (g Gradient) Len() int {
+	return len(g.stops)
+}
+
+// At returns the hex color at t, from 0 at the first stop to 1 at the last
+func (g Gradient) At(t float64) string {
+	switch len(g.stops) {
+	case 0:
+		return "#ffffff"
+	case 1:
+		return g.stops[0].hex()
+	}
+
+	t = Clamp(t, 0, 1) * float64(len(g.stops)-1)
+	i := min(int(t), len(g.stops)-2)
+	return blend(g.stops[i], g.stops[i+1], t-float64(i), g.space).hex()
+}
+
+// Steps returns n evenly spaced colors from the first stop to the last
+func (g Gradient) Steps(n int) []string {
+	if n <= 0 {
+		return nil
+	}
+	colors := make([]string, n)
+	if n == 1 {
+		colors[0] = g.At(1)
+		return colors
+	}
+	for i := range colors {
+		colors[i] = g.At(float64(i) / float64(n-1))
+	}
+	return colors
+}
+
+// Blend mixes two hex colors, t going from 0 (a) to 1 (b), in a color space
+func Blend(a, b string, t float64, space ColorSpace) string {
+	return blend(parseHex(a), parseHex(b), Clamp(t, 0, 1), space).hex()
+}
+
+// Scale multiplies the channels of a hex color by factor, darkening it below
+// 1 and brightening it above
+func Scale(color string, factor float64) string {
+	c := parseHex(color)
+	return rgb{c.r * factor, c.g * factor, c.b * factor}.hex()
+}
+
+// blend mixes two colors in a color space
+func blend(a, b rgb, t float64, space ColorSpace) rgb {
+	switch space {
+	case HSL:
+		h1, s1, l1 := a.hsl()
+		h2, s2, l2 := b.hsl()
+
+		// Grays have no hue, so take the other color's
+		if s1 == 0 {
+			h1 = h2
+		}
+		if s2 == 0 {
+			h2 = h1
+		}
+
+		// The short way around the color wheel
+		dh := h2 - h1
+		if dh > 180 {
+			dh -= 360
+		} else if dh < -180 {
+			dh += 360
+		}
+		return fromHSL(math.Mod(h1+dh*t+360, 360), Lerp(s1, s2, t), Lerp(l1, l2, t))
+
+	case OKLab:
+		l1, a1, b1 := a.oklab()
+		l2, a2, b2 := b.oklab()
+		return fromOKLab(Lerp(l1, l2, t), Lerp(a1, a2, t), Lerp(b1, b2, t))
+	}
+
+	return rgb{Lerp(a.r, b.r, t), Lerp(a.g, b.g, t), Lerp(a.b, b.b, t)}
+}
+
+// parseHex parses "#rrggbb" or "#rgb", returning white if it can't
+func parseHex(hex string) rgb {
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if len(hex) != 6 || err != nil {
+		return rgb{1, 1, 1}
+	}
+	return rgb{float64(v>>16&0xff) / 255, float64(v>>8&0xff) / 255, float64(v&0xff) / 255}
+}
+
+// hex formats the color as "#rrggbb"
+func (c rgb) hex() string {
+	channel := func(v float64) int {
+		return int(math.Round(Clamp(v, 0, 1) * 255))
+	}
+	return fmt.Sprintf("#%02x%02x%02x", channel(c.r), channel(c.g), channel(c.b))
+}
+
+// hsl converts to hue in degrees, saturation and lightness
+func (c rgb) hsl() (h, s, l float64) {
+	hi := math.Max(c.r, math.Max(c.g, c.b))
+	lo := math.Min(c.r, math.Min(c.g, c.b))
+	l = (hi + lo) / 2
+	if hi == lo {
+		return 0, 0, l
+	}
+
+	d := hi - lo
+	if l > 0.5 {
+		s = d / (2 - hi - lo)
+	} else {
+		s = d / (hi + lo)
+	}
+	switch hi {
+	case c.r:
+		h = math.Mod((c.g-c.b)/d+6, 6)
+	case c.g:
+		h = (c.b-c.r)/d + 2
+	default:
+		h = (c.r-c.g)/d + 4
+	}
+	return h * 60, s, l
+}
+
+// fromHSL converts hue in degrees, saturation and lightness to a color
+func fromHSL(h, s, l float64) rgb {
+	chroma := (1 - math.Abs(2*l-1)) * s
+	x := chroma * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := l - chroma/2
+
+	var r, g, b float64
+	switch {
+	case h < 60:
+		r, g, b = chroma, x, 0
+	case h < 120:
+		r, g, b = x, chroma, 0
+	case h < 180:
+		r, g, b = 0, chroma, x
+	case h < 240:
+		r, g, b = 0, x, chroma
+	case h < 300:
+		r, g, b = x, 0, chroma
+	default:
+		r, g, b = chroma, 0, x
+	}
+	return rgb{r + m, g + m, b + m}
+}
+
+// oklab converts to OKLab lightness and a, b axes
+func (c rgb) oklab() (l, a, b float64) {
+	r, g, bl := toLinear(c.r), toLinear(c.g), toLinear(c.b)
+
+	lc := math.Cbrt(0.4122214708*r + 0.5363325363*g + 0.0514459929*bl)
+	mc := math.Cbrt(0.2119034982*r + 0.6806995451*g + 0.1073969566*bl)
+	sc := math.Cbrt(0.0883024619*r + 0.2817188376*g + 0.6299787005*bl)
+
+	return 0.2104542553*lc + 0.7936177850*mc - 0.0040720468*sc,
+		1.9779984951*lc - 2.4285922050*mc + 0.4505937099*sc,
+		0.0259040371*lc + 0.7827717662*mc - 0.8086757660*sc
+}
+
+// fromOKLab converts OKLab lightness and a, b axes to a color
+func fromOKLab(l, a, b float64) rgb {
+	lc := l + 0.3963377774*a + 0.2158037573*b
+	mc := l - 0.1055613458*a - 0.0638541728*b
+	sc := l - 0.0894841775*a - 1.2914855480*b
+	lc, mc, sc = lc*lc*lc, mc*mc*mc, sc*sc*sc
+
+	return rgb{
+		fromLinear(4.0767416621*lc - 3.3077115913*mc + 0.2309699292*sc),
+		fromLinear(-1.2684380046*lc + 2.6097574011*mc - 0.3413193965*sc),
+		fromLinear(-0.0041960863*lc - 0.7034186147*mc + 1.7076147010*sc),
+	}
+}
+
+// toLinear undoes the sRGB gamma curve
+func toLinear(v float64) float64 {
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+// fromLinear applies the sRGB gamma curve
+func fromLinear(v float64) float64 {
+	if v <= 0.0031308 {
+		return v * 12.92
+	}
+	return 1.055*math.Pow(v, 1/2.4) - 0.055
+}
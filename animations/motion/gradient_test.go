@@ -0,0 +1,57 @@
+package motion
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGradientEndpoints(t *testing.T) {
+	stops := []string{"#ff79c6", "#50fa7b", "#8be9fd", "#282a36"}
+	for _, space := range []ColorSpace{RGB, HSL, OKLab} {
+		g := NewGradient(stops...).In(space)
+		if got := g.At(0); got != stops[0] {
+			t.Errorf("%s: At(0) = %s, want %s", space, got, stops[0])
+		}
+		if got := g.At(1); got != stops[len(stops)-1] {
+			t.Errorf("%s: At(1) = %s, want %s", space, got, stops[len(stops)-1])
+		}
+
+		steps := g.Steps(10)
+		if len(steps) != 10 || steps[0] != stops[0] || steps[9] != stops[len(stops)-1] {
+			t.Errorf("%s: Steps(10) = %v, want 10 colors from %s to %s", space, steps, stops[0], stops[len(stops)-1])
+		}
+	}
+}
+
+func TestOKLabRoundTrip(t *testing.T) {
+	for r := 0; r <= 255; r += 5 {
+		for g := 0; g <= 255; g += 5 {
+			for b := 0; b <= 255; b += 5 {
+				c := rgb{float64(r) / 255, float64(g) / 255, float64(b) / 255}
+				back := fromOKLab(c.oklab())
+				for i, pair := range [][2]float64{{c.r, back.r}, {c.g, back.g}, {c.b, back.b}} {
+					if diff := math.Abs(pair[0]-pair[1]) * 255; diff > 1 {
+						t.Fatalf("rgb(%d, %d, %d) channel %d off by %.2f after OKLab round trip", r, g, b, i, diff)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestParseHex(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"#ff79c6", "#ff79c6"},
+		{"FF79C6", "#ff79c6"},
+		{"#f0c", "#ff00cc"},
+		{"not a color", "#ffffff"},
+		{"", "#ffffff"},
+	}
+	for _, tt := range tests {
+		if got := parseHex(tt.in).hex(); got != tt.want {
+			t.Errorf("parseHex(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
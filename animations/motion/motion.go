@@ -0,0 +1,32 @@
+// Package motion provides the easing, path, tween and color gradient helpers
+// shared by the sysc-Go animation effects.
+//
+// Easing functions shape progress over time, paths place points along
+// Bézier curves and splines, tweens step a value from one number to another
+// over a number of frames, and gradients blend smoothly through any number
+// of hex color stops in RGB, HSL or OKLab:
+//
+//	fade := motion.NewGradient("#ffffff", "#ff79c6").In(motion.OKLab)
+//	tween := motion.NewTween(0, 40, 30, motion.OutBounce)
+//	for !tween.Done() {
+//	    x := tween.Step()
+//	    color := fade.At(tween.Progress())
+//	    ...
+//	}
+package motion
+
+// Lerp interpolates linearly from a to b, t going from 0 to 1
+func Lerp(a, b, t float64) float64 {
+	return a + (b-a)*t
+}
+
+// Clamp limits v to the range lo..hi
+func Clamp(v, lo, hi float64) float64 {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
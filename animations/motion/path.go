@@ -0,0 +1,82 @@
+package motion
+
+import "gonum.org/v1/gonum/spatial/r2"
+
+// Path is a curve from t = 0 to t = 1
+type Path interface {
+	// At returns the point at t along the path
+	At(t float64) r2.Vec
+}
+
+// Line is a straight path from From to To
+type Line struct {
+	From, To r2.Vec
+}
+
+// At returns the point at t along the line
+func (l Line) At(t float64) r2.Vec {
+	return r2.Add(l.From, r2.Scale(t, r2.Sub(l.To, l.From)))
+}
+
+// Bezier is a cubic Bézier curve from P0 to P3, pulled toward the control
+// points P1 and P2
+type Bezier struct {
+	P0, P1, P2, P3 r2.Vec
+}
+
+// At returns the point at t along the curve
+func (b Bezier) At(t float64) r2.Vec {
+	return CubicBezier(b.P0, b.P1, b.P2, b.P3, t)
+}
+
+// CubicBezier evaluates a cubic Bézier curve at t
+func CubicBezier(p0, p1, p2, p3 r2.Vec, t float64) r2.Vec {
+	it := 1 - t
+	return r2.Vec{
+		X: it*it*it*p0.X + 3*it*it*t*p1.X + 3*it*t*t*p2.X + t*t*t*p3.X,
+		Y: it*it*it*p0.Y + 3*it*it*t*p1.Y + 3*it*t*t*p2.Y + t*t*t*p3.Y,
+	}
+}
+
+// QuadraticBezier evaluates a quadratic Bézier curve at t
+func QuadraticBezier(p0, p1, p2 r2.Vec, t float64) r2.Vec {
+	it := 1 - t
+	return r2.Vec{
+		X: it*it*p0.X + 2*it*t*p1.X + t*t*p2.X,
+		Y: it*it*p0.Y + 2*it*t*p1.Y + t*t*p2.Y,
+	}
+}
+
+// Spline is a Catmull-Rom spline that passes through every point, spending
+// an equal share of t between each pair of points
+type Spline struct {
+	Points []r2.Vec
+}
+
+// At returns the point at t along the spline
+func (s Spline) At(t float64) r2.Vec {
+	n := len(s.Points)
+	switch n {
+	case 0:
+		return r2.Vec{}
+	case 1:
+		return s.Points[0]
+	}
+
+	// Find the segment and how far along it t is
+	t = Clamp(t, 0, 1) * float64(n-1)
+	i := min(int(t), n-2)
+	u := t - float64(i)
+
+	// The ends repeat their first and last points
+	p0 := s.Points[max(i-1, 0)]
+	p1 := s.Points[i]
+	p2 := s.Points[i+1]
+	p3 := s.Points[min(i+2, n-1)]
+
+	u2, u3 := u*u, u*u*u
+	return r2.Vec{
+		X: 0.5 * (2*p1.X + (p2.X-p0.X)*u + (2*p0.X-5*p1.X+4*p2.X-p3.X)*u2 + (3*p1.X-p0.X-3*p2.X+p3.X)*u3),
+		Y: 0.5 * (2*p1.Y + (p2.Y-p0.Y)*u + (2*p0.Y-5*p1.Y+4*p2.Y-p3.Y)*u2 + (3*p1.Y-p0.Y-3*p2.Y+p3.Y)*u3),
+	}
+}
@@ -0,0 +1,56 @@
+package motion
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+func TestPathEndpoints(t *testing.T) {
+	p0 := r2.Vec{X: 1, Y: 2}
+	p1 := r2.Vec{X: 4, Y: -3}
+	p2 := r2.Vec{X: 9, Y: 7}
+	p3 := r2.Vec{X: 12, Y: 1}
+
+	tests := []struct {
+		name       string
+		path       Path
+		start, end r2.Vec
+	}{
+		{"line", Line{From: p0, To: p3}, p0, p3},
+		{"bezier", Bezier{P0: p0, P1: p1, P2: p2, P3: p3}, p0, p3},
+		{"spline", Spline{Points: []r2.Vec{p0, p1, p2, p3}}, p0, p3},
+		{"spline of two", Spline{Points: []r2.Vec{p0, p3}}, p0, p3},
+	}
+	for _, tt := range tests {
+		if got := tt.path.At(0); !near(got, tt.start) {
+			t.Errorf("%s: At(0) = %v, want %v", tt.name, got, tt.start)
+		}
+		if got := tt.path.At(1); !near(got, tt.end) {
+			t.Errorf("%s: At(1) = %v, want %v", tt.name, got, tt.end)
+		}
+	}
+
+	if got := QuadraticBezier(p0, p1, p3, 0); !near(got, p0) {
+		t.Errorf("QuadraticBezier at 0 = %v, want %v", got, p0)
+	}
+	if got := QuadraticBezier(p0, p1, p3, 1); !near(got, p3) {
+		t.Errorf("QuadraticBezier at 1 = %v, want %v", got, p3)
+	}
+}
+
+func TestSplinePassesThroughPoints(t *testing.T) {
+	points := []r2.Vec{{X: 0, Y: 0}, {X: 3, Y: 5}, {X: 6, Y: -1}, {X: 10, Y: 2}}
+	s := Spline{Points: points}
+	for i, want := range points {
+		at := float64(i) / float64(len(points)-1)
+		if got := s.At(at); !near(got, want) {
+			t.Errorf("At(%v) = %v, want point %d %v", at, got, i, want)
+		}
+	}
+}
+
+// near reports whether two points are equal within rounding
+func near(a, b r2.Vec) bool {
+	return r2.Norm(r2.Sub(a, b)) < 1e-9
+}
@@ -0,0 +1,69 @@
+package motion
+
+import "gonum.org/v1/gonum/spatial/r2"
+
+// Tween steps a value from From to To over Frames frames, shaped by an
+// easing function
+type Tween struct {
+	From, To float64
+	Frames   int
+	Easing   Easing // Linear when nil
+	frame    int
+}
+
+// NewTween creates a tween from one value to another
+func NewTween(from, to float64, frames int, easing Easing) *Tween {
+	return &Tween{From: from, To: to, Frames: frames, Easing: easing}
+}
+
+// Step advances the tween by one frame and returns the new value
+func (t *Tween) Step() float64 {
+	if t.frame < t.Frames {
+		t.frame++
+	}
+	return t.Value()
+}
+
+// Value returns the current value
+func (t *Tween) Value() float64 {
+	easing := t.Easing
+	if easing == nil {
+		easing = Linear
+	}
+	return Lerp(t.From, t.To, easing(t.Progress()))
+}
+
+// Progress returns the share of frames played, from 0 to 1
+func (t *Tween) Progress() float64 {
+	if t.Frames <= 0 {
+		return 1
+	}
+	return float64(t.frame) / float64(t.Frames)
+}
+
+// Done reports whether every frame has played
+func (t *Tween) Done() bool {
+	return t.frame >= t.Frames
+}
+
+// Reset starts the tween over
+func (t *Tween) Reset() {
+	t.frame = 0
+}
+
+// PathTween moves a point along a path over Frames frames, shaped by an
+// easing function
+type PathTween struct {
+	Tween
+	Path Path
+}
+
+// NewPathTween creates a tween along a path
+func NewPathTween(path Path, frames int, easing Easing) *PathTween {
+	return &PathTween{Tween: Tween{From: 0, To: 1, Frames: frames, Easing: easing}, Path: path}
+}
+
+// Point returns the current point along the path
+func (t *PathTween) Point() r2.Vec {
+	return t.Path.At(t.Value())
+}
@@ -198,6 +198,32 @@ func GetRainPalette(themeName string) []string {
 	}
 }
 
+// GetSnowPalette returns theme-specific snow colors, from distant flakes to settled snow
+func GetSnowPalette(themeName string) []string {
+	switch strings.ToLower(themeName) {
+	case "dracula":
+		return []string{"#44475a", "#6272a4", "#bd93f9", "#f8f8f2"}
+	case "catppuccin", "catppuccin-mocha":
+		return []string{"#45475a", "#7f849c", "#b4befe", "#cdd6f4"}
+	case "nord":
+		return []string{"#4c566a", "#81a1c1", "#88c0d0", "#eceff4"}
+	case "tokyo-night", "tokyonight":
+		return []string{"#414868", "#565f89", "#7aa2f7", "#c0caf5"}
+	case "gruvbox":
+		return []string{"#504945", "#7c6f64", "#a89984", "#ebdbb2"}
+	case "material":
+		return []string{"#37474f", "#546e7a", "#89ddff", "#eeffff"}
+	case "solarized":
+		return []string{"#073642", "#586e75", "#93a1a1", "#fdf6e3"}
+	case "monochrome":
+		return []string{"#444444", "#777777", "#aaaaaa", "#ffffff"}
+	case "transishardjob":
+		return []string{"#f7a8b8", "#55cdfc", "#ffffff"}
+	default:
+		return []string{"#555555", "#888888", "#cccccc", "#ffffff"}
+	}
+}
+
 // GetFireworksPalette returns theme-specific fireworks colors
 func GetFireworksPalette(themeName string) []string {
 	switch strings.ToLower(themeName) {
@@ -3,10 +3,11 @@ package animations
 import (
 	"fmt"
 	"math"
+	"math/rand"
 	"sort"
-	"strconv"
 	"strings"
 
+	"github.com/Nomadcxx/sysc-Go/animations/motion"
 	"github.com/charmbracelet/lipgloss/v2"
 )
 
@@ -16,15 +17,18 @@ type PourEffect struct {
 	height                 int
 	text                   string
 	pourDirection          string
+	easing                 motion.Easing
 	pourSpeed              int
 	movementSpeed          float64
 	gap                    int
 	startingColor          string
-	finalGradientStops     []string
+	finalGradient          motion.Gradient
 	finalGradientSteps     int
 	finalGradientFrames    int
 	finalGradientDirection string
-	phase                  string
+	phase                  PourPhase
+	onPhaseChange          func(PourPhase)
+	onComplete             func()
 	frameCount             int
 
 	chars          []PourCharacter
@@ -33,6 +37,26 @@ type PourEffect struct {
 	currentInGroup int
 	gapCounter     int
 	alternateDir   bool // Alternate pouring direction
+	nextRow        int  // Row where appended text starts
+}
+
+// PourPhase is a stage of the pour animation
+type PourPhase int
+
+const (
+	PourPhasePouring  PourPhase = iota // Characters pour into place and fade to their final colors
+	PourPhaseComplete                  // Every character has settled
+)
+
+// String returns the name of the phase
+func (p PourPhase) String() string {
+	switch p {
+	case PourPhasePouring:
+		return "pouring"
+	case PourPhaseComplete:
+		return "complete"
+	}
+	return fmt.Sprintf("PourPhase(%d)", int(p))
 }
 
 // PourCharacter represents a single character in the pour animation
@@ -52,6 +76,25 @@ type PourCharacter struct {
 	gradientCounter int
 }
 
+// PourDirections lists the directions characters can pour in. Diagonals
+// pour toward the named corner, "center-out" from the middle of the screen,
+// "from-edges" from the nearest edge and "random" from a random point on
+// the edges for each character.
+var PourDirections = []string{"down", "up", "left", "right", "down-right", "down-left", "up-right", "up-left", "center-out", "from-edges", "random"}
+
+// PourEasings lists the easing of character movement by name
+var PourEasings = []string{"linear", "quad", "cubic", "bounce", "elastic", "back"}
+
+// pourEasings maps easing names to functions from progress to eased progress
+var pourEasings = map[string]motion.Easing{
+	"linear":  motion.Linear,
+	"quad":    motion.InQuad,
+	"cubic":   motion.InCubic,
+	"bounce":  motion.OutBounce,
+	"elastic": motion.OutElastic,
+	"back":    motion.OutBack,
+}
+
 // PourConfig holds configuration for the pour effect
 type PourConfig struct {
 	Width                  int
@@ -66,24 +109,46 @@ type PourConfig struct {
 	FinalGradientSteps     int
 	FinalGradientFrames    int
 	FinalGradientDirection string
+
+	// Easing shapes each character's movement: "linear", "quad" (default,
+	// speeding up), "cubic", "bounce" (settling like falling sand),
+	// "elastic" or "back" (overshooting and pulling back)
+	Easing string
+
+	// OnPhaseChange is called with the new phase whenever it changes, and
+	// OnComplete once every character has settled. Both are optional and
+	// run inside Update or AppendText.
+	OnPhaseChange func(PourPhase)
+	OnComplete    func()
 }
 
 // NewPourEffect creates a new pour effect with given configuration
 func NewPourEffect(config PourConfig) *PourEffect {
+	if config.PourDirection == "" {
+		config.PourDirection = "down"
+	}
+	easing, ok := pourEasings[config.Easing]
+	if !ok {
+		easing = pourEasings["quad"]
+	}
+
 	effect := &PourEffect{
 		width:                  config.Width,
 		height:                 config.Height,
 		text:                   config.Text,
 		pourDirection:          config.PourDirection,
+		easing:                 easing,
 		pourSpeed:              config.PourSpeed,
 		movementSpeed:          config.MovementSpeed,
 		gap:                    config.Gap,
 		startingColor:          config.StartingColor,
-		finalGradientStops:     config.FinalGradientStops,
+		finalGradient:          motion.NewGradient(config.FinalGradientStops...),
 		finalGradientSteps:     config.FinalGradientSteps,
 		finalGradientFrames:    config.FinalGradientFrames,
 		finalGradientDirection: config.FinalGradientDirection,
-		phase:                  "pouring",
+		phase:                  PourPhasePouring,
+		onPhaseChange:          config.OnPhaseChange,
+		onComplete:             config.OnComplete,
 		frameCount:             0,
 		currentGroup:           0,
 		currentInGroup:         0,
@@ -97,7 +162,10 @@ func NewPourEffect(config PourConfig) *PourEffect {
 
 // Initialize the pour effect with characters and their animations
 func (p *PourEffect) init() {
-	lines := strings.Split(p.text, "\n")
+	var lines []string
+	if p.text != "" {
+		lines = strings.Split(p.text, "\n")
+	}
 
 	// Calculate centered position for text
 	startY := (p.height - len(lines)) / 2
@@ -106,84 +174,230 @@ func (p *PourEffect) init() {
 	}
 
 	// Map text to terminal coordinates
-	for lineIdx, line := range lines {
-		startX := (p.width - len(line)) / 2
-		if startX < 0 {
-			startX = 0
+	p.nextRow = startY
+	for _, line := range lines {
+		p.addLine(line)
+	}
+
+	// Group characters by row or column based on direction
+	p.createGroups(0)
+}
+
+// addLine maps a horizontally centered line of text to the next row
+func (p *PourEffect) addLine(line string) {
+	startX := (p.width - len(line)) / 2
+	if startX < 0 {
+		startX = 0
+	}
+
+	for charIdx, char := range line {
+		if char == ' ' || char == '\t' {
+			continue // Skip whitespace
 		}
 
-		for charIdx, char := range line {
-			if char == ' ' || char == '\t' {
-				continue // Skip whitespace
-			}
+		finalX := startX + charIdx
+		finalY := p.nextRow
+
+		// Skip characters that would be off-screen
+		if finalX >= p.width || finalY >= p.height {
+			continue
+		}
 
-			finalX := startX + charIdx
-			finalY := startY + lineIdx
+		// Calculate gradient color based on terminal coordinates
+		color := p.getGradientColorForCoord(finalX, finalY)
+
+		// Get starting position based on pour direction
+		startX, startY := p.getStartPosition(finalX, finalY)
+
+		p.chars = append(p.chars, PourCharacter{
+			original:        char,
+			finalX:          finalX,
+			finalY:          finalY,
+			startX:          startX,
+			startY:          startY,
+			currentX:        float64(startX),
+			currentY:        float64(startY),
+			visible:         false,
+			color:           p.startingColor,
+			finalColor:      color,
+			progress:        0.0,
+			gradientStep:    0,
+			gradientCounter: 0,
+		})
+	}
+	p.nextRow++
+}
 
-			// Skip characters that would be off-screen
-			if finalX >= p.width || finalY >= p.height {
-				continue
-			}
+// AppendText pours additional lines in below the current text. Once the text
+// no longer fits, earlier lines scroll up off the screen.
+func (p *PourEffect) AppendText(text string) {
+	lines := strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+
+	// Scroll existing characters up to make room
+	if overflow := p.nextRow + len(lines) - p.height; overflow > 0 {
+		for i := range p.chars {
+			char := &p.chars[i]
+			char.finalY -= overflow
+			char.startY -= overflow
+			char.currentY -= float64(overflow)
+		}
+		p.nextRow -= overflow
+	}
 
-			// Calculate gradient color based on terminal coordinates
-			color := p.getGradientColorForCoord(finalX, finalY)
-
-			// Get starting position based on pour direction
-			startX, startY := p.getStartPosition(finalX, finalY)
-
-			p.chars = append(p.chars, PourCharacter{
-				original:        char,
-				finalX:          finalX,
-				finalY:          finalY,
-				startX:          startX,
-				startY:          startY,
-				currentX:        float64(startX),
-				currentY:        float64(startY),
-				visible:         false,
-				color:           p.startingColor,
-				finalColor:      color,
-				progress:        0.0,
-				gradientStep:    0,
-				gradientCounter: 0,
-			})
+	// Once every group has poured, characters that scrolled off can be dropped
+	if p.currentGroup >= len(p.groups) {
+		kept := p.chars[:0]
+		for _, char := range p.chars {
+			if char.finalY >= 0 {
+				kept = append(kept, char)
+			}
 		}
+		p.chars = kept
+		p.groups = nil
+		p.currentGroup = 0
+		p.currentInGroup = 0
 	}
 
-	// Group characters by row or column based on direction
-	p.createGroups()
+	first := len(p.chars)
+	for _, line := range lines {
+		p.addLine(line)
+	}
+	p.createGroups(first)
+
+	p.setPhase(PourPhasePouring)
 }
 
 // Get starting position based on pour direction
 func (p *PourEffect) getStartPosition(finalX, finalY int) (int, int) {
+	right, bottom := p.width-1, p.height-1
 	switch p.pourDirection {
 	case "down":
 		return finalX, 0
 	case "up":
-		return finalX, p.height - 1
+		return finalX, bottom
 	case "left":
-		return p.width - 1, finalY
+		return right, finalY
 	case "right":
 		return 0, finalY
+	case "down-right":
+		// Back along the diagonal to the top or left edge
+		steps := min(finalX, finalY)
+		return finalX - steps, finalY - steps
+	case "down-left":
+		steps := min(right-finalX, finalY)
+		return finalX + steps, finalY - steps
+	case "up-right":
+		steps := min(finalX, bottom-finalY)
+		return finalX - steps, finalY + steps
+	case "up-left":
+		steps := min(right-finalX, bottom-finalY)
+		return finalX + steps, finalY + steps
+	case "center-out":
+		return p.width / 2, p.height / 2
+	case "from-edges":
+		// Straight in from the nearest edge
+		switch min(finalX, right-finalX, finalY, bottom-finalY) {
+		case finalY:
+			return finalX, 0
+		case bottom - finalY:
+			return finalX, bottom
+		case finalX:
+			return 0, finalY
+		default:
+			return right, finalY
+		}
+	case "random":
+		// Anywhere along the edges, in proportion to their length
+		edge := rand.Intn(max(2*(p.width+p.height), 1))
+		switch {
+		case edge < p.width:
+			return edge, 0
+		case edge < 2*p.width:
+			return edge - p.width, bottom
+		case edge < 2*p.width+p.height:
+			return 0, edge - 2*p.width
+		default:
+			return right, edge - 2*p.width - p.height
+		}
 	default:
 		return finalX, 0
 	}
 }
 
-// Create groups of characters by row or column
-func (p *PourEffect) createGroups() {
-	if p.pourDirection == "up" || p.pourDirection == "down" {
-		p.groupByRows()
-	} else {
-		p.groupByColumns()
+// Create groups of characters by row or column, starting at character index first
+func (p *PourEffect) createGroups(first int) {
+	right, bottom := p.width-1, p.height-1
+	switch p.pourDirection {
+	case "up", "down":
+		p.groupByRows(first)
+	case "left", "right":
+		p.groupByColumns(first)
+
+	// Diagonals pour one diagonal line at a time, starting at the far corner
+	case "down-right":
+		p.groupByKey(first, func(c PourCharacter) int { return c.finalX + c.finalY })
+	case "down-left":
+		p.groupByKey(first, func(c PourCharacter) int { return right - c.finalX + c.finalY })
+	case "up-right":
+		p.groupByKey(first, func(c PourCharacter) int { return c.finalX + bottom - c.finalY })
+	case "up-left":
+		p.groupByKey(first, func(c PourCharacter) int { return right - c.finalX + bottom - c.finalY })
+
+	// Rings around the center, nearest first
+	case "center-out":
+		centerX, centerY := float64(p.width/2), float64(p.height/2)
+		p.groupByKey(first, func(c PourCharacter) int {
+			// Cells are about twice as tall as wide
+			return int(math.Hypot(float64(c.finalX)-centerX, (float64(c.finalY)-centerY)*2))
+		})
+
+	// Outermost characters first
+	case "from-edges":
+		p.groupByKey(first, func(c PourCharacter) int {
+			return min(c.finalX, right-c.finalX, c.finalY, bottom-c.finalY)
+		})
+
+	// All at once in random order
+	case "random":
+		group := make([]int, 0, len(p.chars)-first)
+		for i := first; i < len(p.chars); i++ {
+			group = append(group, i)
+		}
+		rand.Shuffle(len(group), func(i, j int) { group[i], group[j] = group[j], group[i] })
+		if len(group) > 0 {
+			p.groups = append(p.groups, group)
+		}
+
+	default:
+		p.groupByColumns(first)
+	}
+}
+
+// groupByKey groups characters with the same key, in ascending key order
+func (p *PourEffect) groupByKey(first int, key func(PourCharacter) int) {
+	keyMap := make(map[int][]int)
+	for i := first; i < len(p.chars); i++ {
+		k := key(p.chars[i])
+		keyMap[k] = append(keyMap[k], i)
+	}
+
+	keys := make([]int, 0, len(keyMap))
+	for k := range keyMap {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+
+	for _, k := range keys {
+		p.groups = append(p.groups, keyMap[k])
 	}
 }
 
 // Group characters by rows (for vertical pouring)
-func (p *PourEffect) groupByRows() {
+func (p *PourEffect) groupByRows(first int) {
 	// Create map of Y coordinate to character indices
 	rowMap := make(map[int][]int)
-	for i, char := range p.chars {
-		rowMap[char.finalY] = append(rowMap[char.finalY], i)
+	for i := first; i < len(p.chars); i++ {
+		rowMap[p.chars[i].finalY] = append(rowMap[p.chars[i].finalY], i)
 	}
 
 	// Get sorted row coordinates
@@ -194,7 +408,6 @@ func (p *PourEffect) groupByRows() {
 	sort.Ints(rows)
 
 	// Create groups in order (top to bottom for down, bottom to top for up)
-	p.groups = make([][]int, 0)
 	
 	if p.pourDirection == "down" {
 		// Pour top to bottom in order
@@ -210,11 +423,11 @@ func (p *PourEffect) groupByRows() {
 }
 
 // Group characters by columns (for horizontal pouring)
-func (p *PourEffect) groupByColumns() {
+func (p *PourEffect) groupByColumns(first int) {
 	// Create map of X coordinate to character indices
 	colMap := make(map[int][]int)
-	for i, char := range p.chars {
-		colMap[char.finalX] = append(colMap[char.finalX], i)
+	for i := first; i < len(p.chars); i++ {
+		colMap[p.chars[i].finalX] = append(colMap[p.chars[i].finalX], i)
 	}
 
 	// Get sorted column coordinates
@@ -225,7 +438,6 @@ func (p *PourEffect) groupByColumns() {
 	sort.Ints(cols)
 
 	// Create groups in order (left to right for right, right to left for left)
-	p.groups = make([][]int, 0)
 	
 	if p.pourDirection == "right" {
 		// Pour left to right in order
@@ -242,13 +454,6 @@ func (p *PourEffect) groupByColumns() {
 
 // Calculate gradient color for a specific coordinate
 func (p *PourEffect) getGradientColorForCoord(x, y int) string {
-	if len(p.finalGradientStops) == 0 {
-		return "#ffffff"
-	}
-	if len(p.finalGradientStops) == 1 {
-		return p.finalGradientStops[0]
-	}
-
 	var ratio float64
 
 	if p.finalGradientDirection == "vertical" {
@@ -263,21 +468,7 @@ func (p *PourEffect) getGradientColorForCoord(x, y int) string {
 		}
 	}
 
-	// Map ratio to gradient stops
-	step := int(ratio * float64(len(p.finalGradientStops)-1))
-	if step >= len(p.finalGradientStops) {
-		step = len(p.finalGradientStops) - 1
-	}
-	if step < 0 {
-		step = 0
-	}
-
-	return p.finalGradientStops[step]
-}
-
-// Easing function for smooth movement
-func (p *PourEffect) easeInQuad(t float64) float64 {
-	return t * t
+	return p.finalGradient.At(ratio)
 }
 
 // Update advances the pour animation by one frame
@@ -285,13 +476,27 @@ func (p *PourEffect) Update() {
 	p.frameCount++
 
 	switch p.phase {
-	case "pouring":
+	case PourPhasePouring:
 		p.updatePouringPhase()
-	case "complete":
+	case PourPhaseComplete:
 		return
 	}
 }
 
+// setPhase moves to a new phase and calls the callbacks
+func (p *PourEffect) setPhase(phase PourPhase) {
+	if p.phase == phase {
+		return
+	}
+	p.phase = phase
+	if p.onPhaseChange != nil {
+		p.onPhaseChange(phase)
+	}
+	if phase == PourPhaseComplete && p.onComplete != nil {
+		p.onComplete()
+	}
+}
+
 // Update the pouring phase of the animation
 func (p *PourEffect) updatePouringPhase() {
 	// Handle gap between group pours
@@ -302,11 +507,13 @@ func (p *PourEffect) updatePouringPhase() {
 		return
 	}
 
-	// Check if all groups are complete
+	// Once all groups have poured, finish once every character has settled
 	if p.currentGroup >= len(p.groups) {
-		p.phase = "complete"
 		p.updateCharacterMovement()
 		p.updateCharacterGradients()
+		if p.allSettled() {
+			p.setPhase(PourPhaseComplete)
+		}
 		return
 	}
 
@@ -335,6 +542,16 @@ func (p *PourEffect) updatePouringPhase() {
 	p.updateCharacterGradients()
 }
 
+// allSettled reports whether every character has reached its final position and color
+func (p *PourEffect) allSettled() bool {
+	for _, char := range p.chars {
+		if char.progress < 1.0 || char.gradientStep < p.finalGradientSteps {
+			return false
+		}
+	}
+	return true
+}
+
 // Update character movement animation
 func (p *PourEffect) updateCharacterMovement() {
 	for i := range p.chars {
@@ -350,7 +567,7 @@ func (p *PourEffect) updateCharacterMovement() {
 		}
 
 		// Apply easing
-		easedProgress := p.easeInQuad(char.progress)
+		easedProgress := p.easing(char.progress)
 
 		// Calculate new position
 		char.currentX = float64(char.startX) + (float64(char.finalX)-float64(char.startX))*easedProgress
@@ -386,7 +603,7 @@ func (p *PourEffect) updateCharacterGradients() {
 				if ratio > 1.0 {
 					ratio = 1.0
 				}
-				char.color = p.interpolateColor(p.startingColor, char.finalColor, ratio)
+				char.color = motion.Blend(p.startingColor, char.finalColor, ratio, motion.RGB)
 			} else {
 				char.color = char.finalColor
 			}
@@ -394,35 +611,6 @@ func (p *PourEffect) updateCharacterGradients() {
 	}
 }
 
-// Interpolate between two colors
-func (p *PourEffect) interpolateColor(startColor, endColor string, ratio float64) string {
-	startR, startG, startB := p.parseHexColor(startColor)
-	endR, endG, endB := p.parseHexColor(endColor)
-
-	r := int(float64(startR) + float64(endR-startR)*ratio)
-	g := int(float64(startG) + float64(endG-startG)*ratio)
-	b := int(float64(startB) + float64(endB-startB)*ratio)
-
-	r = int(math.Max(0, math.Min(255, float64(r))))
-	g = int(math.Max(0, math.Min(255, float64(g))))
-	b = int(math.Max(0, math.Min(255, float64(b))))
-
-	return fmt.Sprintf("#%02x%02x%02x", r, g, b)
-}
-
-// Parse hex color string to RGB values
-func (p *PourEffect) parseHexColor(hex string) (int, int, int) {
-	if len(hex) < 7 || hex[0] != '#' {
-		return 255, 255, 255
-	}
-
-	r, _ := strconv.ParseInt(hex[1:3], 16, 64)
-	g, _ := strconv.ParseInt(hex[3:5], 16, 64)
-	b, _ := strconv.ParseInt(hex[5:7], 16, 64)
-
-	return int(r), int(g), int(b)
-}
-
 // Render converts the pour effect to colored text output
 func (p *PourEffect) Render() string {
 	buffer := make([][]string, p.height)
@@ -457,7 +645,7 @@ func (p *PourEffect) Render() string {
 
 // Reset restarts the animation from the beginning
 func (p *PourEffect) Reset() {
-	p.phase = "pouring"
+	p.setPhase(PourPhasePouring)
 	p.frameCount = 0
 	p.currentGroup = 0
 	p.currentInGroup = 0
@@ -476,3 +664,33 @@ func (p *PourEffect) Reset() {
 		p.chars[i].gradientCounter = 0
 	}
 }
+
+// IsComplete returns whether the animation is finished
+func (p *PourEffect) IsComplete() bool {
+	return p.phase == PourPhaseComplete
+}
+
+// Phase returns the current phase of the animation
+func (p *PourEffect) Phase() PourPhase {
+	return p.phase
+}
+
+// Progress returns how far the animation is from 0 to 1: each character
+// counts half for moving into place and half for fading to its final color
+func (p *PourEffect) Progress() float64 {
+	if p.phase == PourPhaseComplete || len(p.chars) == 0 {
+		return 1
+	}
+	total := 0.0
+	for _, char := range p.chars {
+		if !char.visible {
+			continue
+		}
+		fade := 1.0
+		if p.finalGradientSteps > 0 {
+			fade = math.Min(float64(char.gradientStep)/float64(p.finalGradientSteps), 1)
+		}
+		total += (char.progress + fade) / 2
+	}
+	return total / float64(len(p.chars))
+}
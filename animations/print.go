@@ -1,10 +1,15 @@
 package animations
 
 import (
+	"fmt"
+	"math/rand"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
-	"github.com/charmbracelet/lipgloss"
+	"github.com/Nomadcxx/sysc-Go/animations/motion"
+	"github.com/charmbracelet/lipgloss/v2"
 )
 
 // PrintEffect creates a typewriter/printer effect for text
@@ -14,15 +19,54 @@ type PrintEffect struct {
 	text            string
 	lines           []string
 	currentLine     int
-	currentCol      int
+	typed           []rune // Runes on the current line, typos included
 	revealed        []string
-	lastUpdate      time.Time
+	clock           time.Duration // Time played so far, FrameDuration per Update
+	frameDuration   time.Duration
+	nextAt          time.Duration
 	charDelay       time.Duration
 	printSpeed      int
 	printHeadSymbol string
 	trailSymbols    []string
-	gradientStops   []string
-	complete        bool
+	gradient        motion.Gradient
+	phase           PrintPhase
+	onPhaseChange   func(PrintPhase)
+	onComplete      func()
+	onKeystroke     func(rune)
+	top             int // Row of the first line, negative once text scrolls up
+
+	// Human typing model
+	human            bool
+	jitter           float64
+	punctuationPause time.Duration
+	linePause        time.Duration
+	typoChance       float64
+	typoAt           int // Index of the uncorrected typo in typed, or -1
+	noticeIn         int // Runes left to type before the typo is noticed
+	backspacing      bool
+	carriageReturn   time.Duration
+	returning        bool
+	returnStart      time.Duration
+	rng              *rand.Rand
+}
+
+// PrintPhase is a stage of the print animation
+type PrintPhase int
+
+const (
+	PrintPhasePrinting PrintPhase = iota // The print head types out the text
+	PrintPhaseComplete                   // Every line is printed
+)
+
+// String returns the name of the phase
+func (p PrintPhase) String() string {
+	switch p {
+	case PrintPhasePrinting:
+		return "printing"
+	case PrintPhaseComplete:
+		return "complete"
+	}
+	return fmt.Sprintf("PrintPhase(%d)", int(p))
 }
 
 // PrintConfig holds configuration for the print effect
@@ -35,6 +79,37 @@ type PrintConfig struct {
 	PrintHeadSymbol string
 	TrailSymbols    []string
 	GradientStops   []string
+
+	// Human types one rune per keystroke with a varying cadence instead of
+	// PrintSpeed runes every CharDelay. Jitter varies each delay by up to
+	// that fraction, PunctuationPause and LinePause are added after
+	// punctuation and at line ends, and TypoChance is the chance a letter
+	// hits a neighbouring key and is backspaced a few keystrokes later.
+	Human            bool
+	Jitter           float64       // Default 0.5
+	PunctuationPause time.Duration // Default 250ms
+	LinePause        time.Duration // Default 400ms
+	TypoChance       float64       // Default 0.03
+
+	// CarriageReturn is how long the print head takes to sweep back to the
+	// start of the next line. Defaults to 300ms in human mode; zero in
+	// steady mode jumps straight to the next line.
+	CarriageReturn time.Duration
+
+	// OnKeystroke is called for every key the typist presses: each printed
+	// rune, '\b' for a backspace and '\n' for a carriage return. Use it to
+	// play a click sound or log events. It runs inside Update.
+	OnKeystroke func(rune)
+
+	// FrameDuration is the time one Update stands for, default 50ms. Set it
+	// to the delay between frames so delays play out in real time.
+	FrameDuration time.Duration
+
+	// OnPhaseChange is called with the new phase whenever it changes, and
+	// OnComplete once every line is printed. Both are optional and run
+	// inside Update or AppendText.
+	OnPhaseChange func(PrintPhase)
+	OnComplete    func()
 }
 
 // NewPrintEffect creates a new print effect with given configuration
@@ -67,57 +142,259 @@ func NewPrintEffect(config PrintConfig) *PrintEffect {
 		gradientStops = []string{"#ffffff"}
 	}
 
+	if config.FrameDuration <= 0 {
+		config.FrameDuration = 50 * time.Millisecond
+	}
+
+	if config.Human {
+		if config.Jitter <= 0 {
+			config.Jitter = 0.5
+		}
+		if config.PunctuationPause <= 0 {
+			config.PunctuationPause = 250 * time.Millisecond
+		}
+		if config.LinePause <= 0 {
+			config.LinePause = 400 * time.Millisecond
+		}
+		if config.TypoChance <= 0 {
+			config.TypoChance = 0.03
+		}
+		if config.CarriageReturn <= 0 {
+			config.CarriageReturn = 300 * time.Millisecond
+		}
+	}
+
+	// Center the initial text block vertically
+	top := (config.Height - len(lines)) / 2
+	if top < 0 {
+		top = 0
+	}
+
 	return &PrintEffect{
-		width:           config.Width,
-		height:          config.Height,
-		text:            config.Text,
-		lines:           lines,
-		currentLine:     0,
-		currentCol:      0,
-		revealed:        []string{},
-		lastUpdate:      time.Now(),
-		charDelay:       config.CharDelay,
-		printSpeed:      printSpeed,
-		printHeadSymbol: printHeadSymbol,
-		trailSymbols:    trailSymbols,
-		gradientStops:   gradientStops,
-		complete:        false,
+		width:            config.Width,
+		height:           config.Height,
+		text:             config.Text,
+		lines:            lines,
+		currentLine:      0,
+		revealed:         []string{},
+		frameDuration:    config.FrameDuration,
+		nextAt:           config.CharDelay,
+		charDelay:        config.CharDelay,
+		printSpeed:       printSpeed,
+		printHeadSymbol:  printHeadSymbol,
+		trailSymbols:     trailSymbols,
+		gradient:         motion.NewGradient(gradientStops...),
+		phase:            PrintPhasePrinting,
+		onPhaseChange:    config.OnPhaseChange,
+		onComplete:       config.OnComplete,
+		onKeystroke:      config.OnKeystroke,
+		top:              top,
+		human:            config.Human,
+		jitter:           config.Jitter,
+		punctuationPause: config.PunctuationPause,
+		linePause:        config.LinePause,
+		typoChance:       config.TypoChance,
+		typoAt:           -1,
+		carriageReturn:   config.CarriageReturn,
+		rng:              rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
 }
 
+// AppendText adds lines to be printed after the current text. Once the text
+// no longer fits, earlier lines scroll up off the screen.
+func (p *PrintEffect) AppendText(text string) {
+	newLines := strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+
+	// Drop lines that have already scrolled off the top
+	if offscreen := -p.viewTop(); offscreen > 0 {
+		drop := offscreen
+		if drop > len(p.revealed) {
+			drop = len(p.revealed)
+		}
+		p.top = p.viewTop() + drop
+		p.lines = p.lines[drop:]
+		p.revealed = p.revealed[drop:]
+		p.currentLine -= drop
+	}
+
+	p.lines = append(p.lines, newLines...)
+	p.text = strings.Join(p.lines, "\n")
+	p.setPhase(PrintPhasePrinting)
+}
+
+// viewTop returns the row of the first line, scrolled so the line being
+// printed stays on screen
+func (p *PrintEffect) viewTop() int {
+	active := p.currentLine
+	if active >= len(p.lines) {
+		active = len(p.lines) - 1
+	}
+	if p.top+active >= p.height {
+		return p.height - 1 - active
+	}
+	return p.top
+}
+
 // Update advances the print effect animation
 func (p *PrintEffect) Update() {
-	if p.complete {
+	if p.phase == PrintPhaseComplete {
 		return
 	}
 
-	currentTime := time.Now()
+	p.clock += p.frameDuration
+	currentTime := p.clock
 
 	// Check if animation is complete
 	if p.currentLine >= len(p.lines) {
-		p.complete = true
+		p.setPhase(PrintPhaseComplete)
 		return
 	}
 
-	// Check if enough time has passed to print next character(s)
-	if currentTime.Sub(p.lastUpdate) >= p.charDelay {
-		currentLineText := p.lines[p.currentLine]
-		runes := []rune(currentLineText)
+	// Let the print head finish its carriage return
+	if p.returning {
+		if currentTime-p.returnStart < p.carriageReturn {
+			return
+		}
+		p.returning = false
+	}
+
+	if currentTime < p.nextAt {
+		return
+	}
 
-		// Print multiple characters based on printSpeed
-		for i := 0; i < p.printSpeed && p.currentCol < len(runes); i++ {
-			p.currentCol++
+	if p.human {
+		p.nextAt = currentTime + p.typeHuman(currentTime)
+		return
+	}
+
+	// Print multiple characters based on printSpeed
+	runes := []rune(p.lines[p.currentLine])
+	for i := 0; i < p.printSpeed && len(p.typed) < len(runes); i++ {
+		p.keystroke(runes[len(p.typed)])
+	}
+
+	// Check if line is complete
+	if len(p.typed) >= len(runes) {
+		p.finishLine(currentTime)
+	}
+
+	p.nextAt = currentTime + p.charDelay
+}
+
+// typeHuman presses a single key and returns how long to wait before the
+// next one
+func (p *PrintEffect) typeHuman(now time.Duration) time.Duration {
+	runes := []rune(p.lines[p.currentLine])
+	delay := p.jittered(p.charDelay)
+
+	// Fix a typo once it is noticed, or before leaving the line
+	if p.typoAt >= 0 && (p.backspacing || p.noticeIn <= 0 || len(p.typed) >= len(runes)) {
+		if !p.backspacing {
+			p.backspacing = true
+			return delay * 3
 		}
+		p.typed = p.typed[:len(p.typed)-1]
+		p.emit('\b')
+		if len(p.typed) <= p.typoAt {
+			p.typoAt = -1
+			p.backspacing = false
+		}
+		return delay * 3 / 5
+	}
+
+	if len(p.typed) >= len(runes) {
+		p.finishLine(now)
+		return delay
+	}
 
-		// Check if line is complete
-		if p.currentCol >= len(runes) {
-			p.revealed = append(p.revealed, currentLineText)
-			p.currentLine++
-			p.currentCol = 0
+	want := runes[len(p.typed)]
+	if p.typoAt >= 0 {
+		p.noticeIn--
+	} else if p.rng.Float64() < p.typoChance {
+		if wrong, ok := neighbourKey(want, p.rng); ok {
+			p.typoAt = len(p.typed)
+			p.noticeIn = p.rng.Intn(3)
+			p.keystroke(wrong)
+			return delay
 		}
+	}
+	p.keystroke(want)
 
-		p.lastUpdate = currentTime
+	if strings.ContainsRune(".,;:!?", want) {
+		delay += p.punctuationPause
+	}
+	if len(p.typed) >= len(runes) && p.typoAt < 0 {
+		delay += p.linePause
 	}
+	return delay
+}
+
+// keystroke types a rune on the current line
+func (p *PrintEffect) keystroke(r rune) {
+	p.typed = append(p.typed, r)
+	p.emit(r)
+}
+
+// emit reports a key press to the OnKeystroke hook
+func (p *PrintEffect) emit(r rune) {
+	if p.onKeystroke != nil {
+		p.onKeystroke(r)
+	}
+}
+
+// finishLine moves the print head to the next line
+func (p *PrintEffect) finishLine(now time.Duration) {
+	p.revealed = append(p.revealed, p.lines[p.currentLine])
+	p.currentLine++
+	p.typed = p.typed[:0]
+
+	if p.currentLine < len(p.lines) {
+		p.emit('\n')
+		if p.carriageReturn > 0 {
+			p.returning = true
+			p.returnStart = now
+		}
+	}
+}
+
+// jittered varies d by up to the jitter fraction either way
+func (p *PrintEffect) jittered(d time.Duration) time.Duration {
+	if p.jitter <= 0 {
+		return d
+	}
+	scale := 1 + p.jitter*(2*p.rng.Float64()-1)
+	if scale < 0 {
+		scale = 0
+	}
+	return time.Duration(float64(d) * scale)
+}
+
+// qwertyRows are the letter rows used to pick a neighbouring key for typos
+var qwertyRows = []string{"qwertyuiop", "asdfghjkl", "zxcvbnm"}
+
+// neighbourKey returns a key next to r on a QWERTY keyboard, keeping its
+// case. Only letters have neighbours.
+func neighbourKey(r rune, rng *rand.Rand) (rune, bool) {
+	lower := unicode.ToLower(r)
+	for _, row := range qwertyRows {
+		i := strings.IndexRune(row, lower)
+		if i < 0 {
+			continue
+		}
+		var keys []rune
+		if i > 0 {
+			keys = append(keys, rune(row[i-1]))
+		}
+		if i < len(row)-1 {
+			keys = append(keys, rune(row[i+1]))
+		}
+		key := keys[rng.Intn(len(keys))]
+		if unicode.IsUpper(r) {
+			key = unicode.ToUpper(key)
+		}
+		return key, true
+	}
+	return r, false
 }
 
 // Render converts the print effect to text output
@@ -132,34 +409,29 @@ func (p *PrintEffect) Render() string {
 		}
 	}
 
-	// Calculate centered starting position
-	startY := (p.height - len(p.lines)) / 2
-	if startY < 0 {
-		startY = 0
-	}
+	// Starting row, scrolled to keep the print head on screen
+	startY := p.viewTop()
 
 	// Render revealed lines and current line being printed
 	for lineIdx := 0; lineIdx < len(p.revealed); lineIdx++ {
 		y := startY + lineIdx
+		if y < 0 {
+			continue
+		}
 		if y >= p.height {
 			break
 		}
 
-		line := p.revealed[lineIdx]
-		startX := (p.width - len(line)) / 2
-		if startX < 0 {
-			startX = 0
-		}
+		runes := []rune(p.revealed[lineIdx])
+		startX := p.lineStart(p.revealed[lineIdx])
 
-		for charIdx, char := range line {
+		for charIdx, char := range runes {
 			x := startX + charIdx
 			if x >= p.width {
 				break
 			}
-			
-			// Calculate gradient color
-			color := p.getGradientColor(float64(charIdx) / float64(len(line)))
-			style := lipgloss.NewStyle().Foreground(lipgloss.Color(color))
+
+			style := lipgloss.NewStyle().Foreground(lipgloss.Color(p.colorAt(charIdx, len(runes))))
 			buffer[y][x] = style.Render(string(char))
 		}
 	}
@@ -167,31 +439,33 @@ func (p *PrintEffect) Render() string {
 	// Render current line being printed
 	if p.currentLine < len(p.lines) {
 		y := startY + len(p.revealed)
-		if y < p.height {
-			currentLineText := p.lines[p.currentLine]
-			runes := []rune(currentLineText)
-			
-			startX := (p.width - len(currentLineText)) / 2
-			if startX < 0 {
-				startX = 0
-			}
-
-			// Render revealed portion of current line
-			if p.currentCol > 0 {
-				revealedText := string(runes[:min(p.currentCol, len(runes))])
-				for charIdx, char := range revealedText {
+		if y >= 0 && y < p.height {
+			runes := []rune(p.lines[p.currentLine])
+			startX := p.lineStart(p.lines[p.currentLine])
+
+			// Sweep the print head back from the end of the previous line
+			if p.returning && len(p.revealed) > 0 {
+				prev := p.revealed[len(p.revealed)-1]
+				from := p.lineStart(prev) + utf8.RuneCountInString(prev) + len(p.trailSymbols)
+				t := float64(p.clock-p.returnStart) / float64(p.carriageReturn)
+				headX := from + int(float64(startX-from)*motion.OutCubic(motion.Clamp(t, 0, 1)))
+				if headX >= 0 && headX < p.width {
+					buffer[y][headX] = p.printHeadSymbol
+				}
+			} else if len(p.typed) > 0 {
+				// Render revealed portion of current line
+				for charIdx, char := range p.typed {
 					x := startX + charIdx
 					if x >= p.width {
 						break
 					}
-					
-					color := p.getGradientColor(float64(charIdx) / float64(len(currentLineText)))
-					style := lipgloss.NewStyle().Foreground(lipgloss.Color(color))
+
+					style := lipgloss.NewStyle().Foreground(lipgloss.Color(p.colorAt(charIdx, len(runes))))
 					buffer[y][x] = style.Render(string(char))
 				}
 
 				// Add trail effect
-				trailX := startX + p.currentCol
+				trailX := startX + len(p.typed)
 				for i, trailSymbol := range p.trailSymbols {
 					x := trailX + i
 					if x >= p.width {
@@ -227,32 +501,18 @@ func (p *PrintEffect) Render() string {
 	return strings.Join(lines, "\n")
 }
 
-// Helper to get gradient color for position
-func (p *PrintEffect) getGradientColor(progress float64) string {
-	if len(p.gradientStops) == 0 {
-		return "#ffffff"
-	}
-	if len(p.gradientStops) == 1 {
-		return p.gradientStops[0]
-	}
-
-	// Map progress to gradient position
-	totalStops := len(p.gradientStops)
-	segmentSize := 1.0 / float64(totalStops-1)
-	segment := int(progress / segmentSize)
-
-	if segment >= totalStops-1 {
-		return p.gradientStops[totalStops-1]
-	}
-
-	return p.gradientStops[segment]
+// lineStart returns the column that centers a line horizontally
+func (p *PrintEffect) lineStart(line string) int {
+	return max((p.width-utf8.RuneCountInString(line))/2, 0)
 }
 
-func min(a, b int) int {
-	if a < b {
-		return a
+// colorAt returns the gradient color of rune i in a line of n runes, running
+// from the first stop at the start of the line to the last at its end
+func (p *PrintEffect) colorAt(i, n int) string {
+	if n <= 1 {
+		return p.gradient.At(0)
 	}
-	return b
+	return p.gradient.At(float64(i) / float64(n-1))
 }
 
 // Reset restarts the print effect animation
@@ -264,13 +524,63 @@ func (p *PrintEffect) Reset() {
 
 	p.lines = lines
 	p.currentLine = 0
-	p.currentCol = 0
+	p.typed = p.typed[:0]
+	p.typoAt = -1
+	p.backspacing = false
+	p.returning = false
 	p.revealed = []string{}
-	p.lastUpdate = time.Now()
-	p.complete = false
+	p.clock = 0
+	p.nextAt = p.charDelay
+	p.setPhase(PrintPhasePrinting)
+
+	p.top = (p.height - len(lines)) / 2
+	if p.top < 0 {
+		p.top = 0
+	}
 }
 
 // IsComplete returns whether the animation is finished
 func (p *PrintEffect) IsComplete() bool {
-	return p.complete
+	return p.phase == PrintPhaseComplete
+}
+
+// setPhase moves to a new phase and calls the callbacks
+func (p *PrintEffect) setPhase(phase PrintPhase) {
+	if p.phase == phase {
+		return
+	}
+	p.phase = phase
+	if p.onPhaseChange != nil {
+		p.onPhaseChange(phase)
+	}
+	if phase == PrintPhaseComplete && p.onComplete != nil {
+		p.onComplete()
+	}
+}
+
+// Phase returns the current phase of the animation
+func (p *PrintEffect) Phase() PrintPhase {
+	return p.phase
+}
+
+// Progress returns the share of characters printed, from 0 to 1
+func (p *PrintEffect) Progress() float64 {
+	printed, total := 0, 0
+	for i, line := range p.lines {
+		n := len([]rune(line))
+		total += n
+		if i < p.currentLine {
+			printed += n
+		} else if i == p.currentLine {
+			correct := len(p.typed)
+			if p.typoAt >= 0 {
+				correct = p.typoAt
+			}
+			printed += min(correct, n)
+		}
+	}
+	if total == 0 || p.phase == PrintPhaseComplete {
+		return 1
+	}
+	return float64(printed) / float64(total)
 }
@@ -1,6 +1,7 @@
 package animations
 
 import (
+	"math"
 	"math/rand"
 	"strings"
 
@@ -9,32 +10,168 @@ import (
 
 // RainEffect implements ASCII character rain animation
 type RainEffect struct {
-	width    int      // Terminal width
-	height   int      // Terminal height
-	palette  []string // Theme color palette
-	chars    []rune   // Raindrop characters
-	drops    []RainDrop
-	maxDrops int // Maximum number of simultaneous drops
+	dropField          // Falling drops
+	width     int      // Terminal width
+	height    int      // Terminal height
+	palette   []string // Theme color palette
+	chars     []rune   // Raindrop characters
+	maxDrops  int      // Maximum number of simultaneous drops
+
+	// Weather mode
+	wind            float64 // Sideways cells per row fallen
+	weather         bool    // Splashes, changing intensity and lightning
+	intensityPeriod int     // Frames of a drizzle-storm-drizzle cycle
+	intensity       float64 // 0 = drizzle, 1 = storm
+	lightningChance float64 // Chance per frame of lightning at full storm
+	lightningColor  string  // Flash color
+	flash           int     // Frames of the current lightning flash left
+	splashes        []rainSplash
+	frame           int
 }
 
 // RainDrop represents a single falling character
 type RainDrop struct {
-	X     int    // X position
-	Y     int    // Y position
-	Speed int    // Falling speed
-	Char  rune   // Character to display
-	Color string // Color hex code
+	X     int     // X position
+	Y     int     // Y position
+	Speed float64 // Rows fallen per frame
+	Char  rune    // Character to display
+	Color string  // Color hex code
+
+	// Snow flakes are drops that fall at a depth and sway from side to side
+	Depth int     // Layer from 0 (nearest) back
+	Sway  float64 // Sway amplitude in columns
+	Phase float64 // Sway phase
+
+	drift float64 // Sideways movement carried over to the next frame
+	fall  float64 // Downward movement carried over to the next frame
+}
+
+// step moves the drop down by its speed and dx columns sideways, carrying
+// fractions of a cell over to the next frame, and wraps it around the columns
+func (d *RainDrop) step(dx float64, width int) {
+	d.fall += d.Speed
+	rows := math.Trunc(d.fall)
+	d.fall -= rows
+	d.Y += int(rows)
+
+	d.drift += dx
+	shift := math.Trunc(d.drift)
+	d.drift -= shift
+	if width > 0 {
+		d.X = ((d.X+int(shift))%width + width) % width
+	}
+}
+
+// dropField is the particle system rain and snow are built on. Drops fall
+// and drift sideways, and the ones that land are respawned above the screen
+// as long as there are fewer than the target.
+type dropField struct {
+	drops []RainDrop
+	spawn func(y int) RainDrop         // Creates a drop at row y
+	drift func(d RainDrop) float64     // Sideways columns a drop moves this frame
+	land  func(from, to RainDrop) bool // Reports whether a drop that moved from one place to another landed
+}
+
+// fall moves every drop one frame, respawning the ones that land while
+// there are fewer than target
+func (f *dropField) fall(width, target int) {
+	active := f.drops[:0] // Reuse slice for efficiency
+	for _, drop := range f.drops {
+		from := drop
+		drop.step(f.drift(drop), width)
+		if f.land(from, drop) {
+			if len(active) >= target {
+				continue
+			}
+			drop = f.spawn(-rand.Intn(10)) // Start above screen
+		}
+		active = append(active, drop)
+	}
+	f.drops = active
+}
+
+// fill adds drops above the screen, each with a chance, up to target
+func (f *dropField) fill(target int, chance float64) {
+	for len(f.drops) < target && rand.Float64() < chance {
+		f.drops = append(f.drops, f.spawn(-rand.Intn(10))) // Start above screen
+	}
+}
+
+// rainSplash is a droplet thrown up where a drop hits the ground
+type rainSplash struct {
+	x, y  int
+	dx    int // Sideways direction
+	life  int // Frames left
+	char  rune
+	color string
+}
+
+// RainConfig holds configuration for the rain effect
+type RainConfig struct {
+	Width   int
+	Height  int
+	Palette []string
+
+	Wind float64 // Sideways cells per row fallen, negative blows left; slanted rain falls as / or \
+
+	// Weather adds splashes, intensity ramping between drizzle and storm and
+	// lightning flashes
+	Weather         bool
+	IntensityPeriod int     // Frames of a drizzle-storm-drizzle cycle, default 600
+	LightningChance float64 // Chance per frame of lightning at full storm, default 0.01
+	LightningColor  string  // Flash color, default white
 }
 
 // NewRainEffect creates a new rain effect with given dimensions and theme palette
 func NewRainEffect(width, height int, palette []string) *RainEffect {
+	return NewRainEffectWithConfig(RainConfig{
+		Width:   width,
+		Height:  height,
+		Palette: palette,
+	})
+}
+
+// NewRainEffectWithConfig creates a rain effect with wind and weather
+func NewRainEffectWithConfig(config RainConfig) *RainEffect {
+	if config.IntensityPeriod == 0 {
+		config.IntensityPeriod = 600
+	}
+	if config.LightningChance == 0 {
+		config.LightningChance = 0.01
+	}
+	if config.LightningColor == "" {
+		config.LightningColor = "#ffffff"
+	}
+
+	chars := []rune{'|', '⋮', '║', '¦', '┆', '┊', '╎', '╏', '▏', '▎', '▍', '▌', '▋', '▊', '▉'}
+	switch {
+	case config.Wind >= 0.25:
+		chars = []rune{'\\'} // Falling to the right
+	case config.Wind <= -0.25:
+		chars = []rune{'/'}
+	}
+
 	r := &RainEffect{
-		width:    width,
-		height:   height,
-		palette:  palette,
-		chars:    []rune{'|', '⋮', '║', '¦', '┆', '┊', '╎', '╏', '▏', '▎', '▍', '▌', '▋', '▊', '▉'},
-		drops:    make([]RainDrop, 0, 200),
-		maxDrops: width * 2, // More drops for wider terminals
+		width:           config.Width,
+		height:          config.Height,
+		palette:         config.Palette,
+		chars:           chars,
+		maxDrops:        config.Width * 2, // More drops for wider terminals
+		wind:            config.Wind,
+		weather:         config.Weather,
+		intensityPeriod: config.IntensityPeriod,
+		intensity:       1,
+		lightningChance: config.LightningChance,
+		lightningColor:  config.LightningColor,
+	}
+	r.dropField = dropField{
+		drops: make([]RainDrop, 0, 200),
+		spawn: r.newDrop,
+		drift: r.windDrift,
+		land:  r.landDrop,
+	}
+	if r.weather {
+		r.intensity = 0.1 // Start as a drizzle
 	}
 	r.init()
 	return r
@@ -43,15 +180,19 @@ func NewRainEffect(width, height int, palette []string) *RainEffect {
 // Initialize rain effect with some initial drops
 func (r *RainEffect) init() {
 	// Create initial drops scattered across width
-	for i := 0; i < r.width/3; i++ {
-		drop := RainDrop{
-			X:     rand.Intn(r.width),
-			Y:     -rand.Intn(r.height), // Start above screen
-			Speed: rand.Intn(3) + 1,     // Speed 1-3
-			Char:  r.chars[rand.Intn(len(r.chars))],
-			Color: r.getRandomColor(),
-		}
-		r.drops = append(r.drops, drop)
+	for i := 0; i < int(float64(r.width/3)*r.intensity); i++ {
+		r.drops = append(r.drops, r.newDrop(-rand.Intn(max(r.height, 1)))) // Start above screen
+	}
+}
+
+// newDrop creates a drop at a random column and row y
+func (r *RainEffect) newDrop(y int) RainDrop {
+	return RainDrop{
+		X:     rand.Intn(max(r.width, 1)),
+		Y:     y,
+		Speed: float64(rand.Intn(3) + 1), // Speed 1-3
+		Char:  r.chars[rand.Intn(len(r.chars))],
+		Color: r.getRandomColor(),
 	}
 }
 
@@ -76,37 +217,95 @@ func (r *RainEffect) getRandomColor() string {
 	return r.palette[rand.Intn(len(r.palette))]
 }
 
+// Intensity returns how hard it rains, from 0 (drizzle) to 1 (storm)
+func (r *RainEffect) Intensity() float64 {
+	return r.intensity
+}
+
 // Update advances the rain simulation by one frame
 func (r *RainEffect) Update() {
-	// Update existing drops
-	activeDrops := r.drops[:0] // Reuse slice for efficiency
-	for _, drop := range r.drops {
-		// Move drop downward
-		drop.Y += drop.Speed
-
-		// Reset drop when it reaches bottom
-		if drop.Y >= r.height {
-			drop.Y = -rand.Intn(10) // Start above screen
-			drop.X = rand.Intn(r.width)
-			drop.Speed = rand.Intn(3) + 1 // Speed 1-3
-			drop.Char = r.chars[rand.Intn(len(r.chars))]
-			drop.Color = r.getRandomColor()
+	r.frame++
+	if r.weather {
+		r.updateWeather()
+	}
+
+	// Fewer drops fall back in as the rain eases off
+	target := int(float64(r.maxDrops) * r.intensity)
+
+	// Move drops downward, and sideways with the wind
+	r.fall(r.width, target)
+
+	// Add new drops randomly, quicker as a storm builds up
+	spawnChance := 0.3
+	if r.weather {
+		spawnChance = 0.9 * r.intensity
+	}
+	r.fill(target, spawnChance)
+
+	// Splashes fly outward and fall back
+	activeSplashes := r.splashes[:0]
+	for _, s := range r.splashes {
+		s.life--
+		if s.life <= 0 {
+			continue
 		}
+		s.x += s.dx
+		if s.life == 1 {
+			s.y++
+			s.char = '.'
+		}
+		activeSplashes = append(activeSplashes, s)
+	}
+	r.splashes = activeSplashes
+}
+
+// windDrift returns how far the wind blows a drop sideways this frame
+func (r *RainEffect) windDrift(drop RainDrop) float64 {
+	return r.wind * drop.Speed
+}
+
+// landDrop reports whether a drop reached the ground, splashing it in
+// weather mode
+func (r *RainEffect) landDrop(from, to RainDrop) bool {
+	if to.Y < r.height {
+		return false
+	}
+	if r.weather {
+		r.splash(to)
+	}
+	return true
+}
+
+// updateWeather ramps the intensity and strikes lightning
+func (r *RainEffect) updateWeather() {
+	phase := 2 * math.Pi * float64(r.frame) / float64(r.intensityPeriod)
+	r.intensity = 0.55 - 0.45*math.Cos(phase)
 
-		activeDrops = append(activeDrops, drop)
+	if r.flash > 0 {
+		r.flash--
+	} else if rand.Float64() < r.lightningChance*r.intensity*r.intensity {
+		r.flash = 4
 	}
-	r.drops = activeDrops
+}
 
-	// Add new drops randomly
-	for len(r.drops) < r.maxDrops && rand.Float64() < 0.3 {
-		drop := RainDrop{
-			X:     rand.Intn(r.width),
-			Y:     -rand.Intn(10),   // Start above screen
-			Speed: rand.Intn(3) + 1, // Speed 1-3
-			Char:  r.chars[rand.Intn(len(r.chars))],
-			Color: r.getRandomColor(),
+// splash throws droplets up on either side of where a drop landed
+func (r *RainEffect) splash(drop RainDrop) {
+	for _, dx := range []int{-1, 1} {
+		if rand.Float64() < 0.5 {
+			continue
+		}
+		char := '\''
+		if dx < 0 {
+			char = '`'
 		}
-		r.drops = append(r.drops, drop)
+		r.splashes = append(r.splashes, rainSplash{
+			x:     drop.X,
+			y:     r.height - 2,
+			dx:    dx,
+			life:  3,
+			char:  char,
+			color: drop.Color,
+		})
 	}
 }
 
@@ -131,6 +330,20 @@ func (r *RainEffect) Render() string {
 			colors[drop.Y][drop.X] = drop.Color
 		}
 	}
+	for _, s := range r.splashes {
+		if s.y >= 0 && s.y < r.height && s.x >= 0 && s.x < r.width {
+			canvas[s.y][s.x] = s.char
+			colors[s.y][s.x] = s.color
+		}
+	}
+
+	// Lightning strikes twice: the sky lights up, dims, then lights up again,
+	// and the rain catches the light in between
+	var flashStyle lipgloss.Style
+	lit := r.flash == 4 || r.flash == 2
+	if lit {
+		flashStyle = lipgloss.NewStyle().Background(lipgloss.Color(r.lightningColor))
+	}
 
 	// Convert to colored string
 	var lines []string
@@ -138,13 +351,24 @@ func (r *RainEffect) Render() string {
 		var line strings.Builder
 		for x := 0; x < r.width; x++ {
 			char := canvas[y][x]
-			if char != ' ' && colors[y][x] != "" {
+			color := colors[y][x]
+			if r.flash > 0 && color != "" {
+				color = r.lightningColor
+			}
+			switch {
+			case lit:
+				style := flashStyle
+				if char != ' ' {
+					style = style.Foreground(lipgloss.Color("#000000")) // Drops in silhouette
+				}
+				line.WriteString(style.Render(string(char)))
+			case char != ' ' && color != "":
 				// Render colored character
 				styled := lipgloss.NewStyle().
-					Foreground(lipgloss.Color(colors[y][x])).
+					Foreground(lipgloss.Color(color)).
 					Render(string(char))
 				line.WriteString(styled)
-			} else {
+			default:
 				line.WriteRune(char)
 			}
 		}
@@ -157,5 +381,11 @@ func (r *RainEffect) Render() string {
 // Reset restarts the animation from the beginning
 func (r *RainEffect) Reset() {
 	r.drops = r.drops[:0]
+	r.splashes = r.splashes[:0]
+	r.frame = 0
+	r.flash = 0
+	if r.weather {
+		r.intensity = 0.1
+	}
 	r.init()
 }
@@ -0,0 +1,318 @@
+package animations
+
+import (
+	"math"
+	"math/rand"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss/v2"
+)
+
+// SnowEffect implements falling snow that settles into drifts. Flakes are
+// rain drops that sway as they fall at a depth.
+type SnowEffect struct {
+	dropField          // Falling flakes
+	width     int      // Terminal width
+	height    int      // Terminal height
+	palette   []string // Theme colors from distant flakes to settled snow
+	layers    int      // Number of depth layers
+	density   float64  // Flakes per screen cell
+	maxFlakes int      // Maximum number of simultaneous flakes
+	meltRate  float64  // Snow melted from each exposed cell per frame
+	maxDrift  int      // Tallest drift on the ground in rows
+
+	text      []string // Text snow settles on, centered on screen
+	textColor string
+	solid     [][]bool    // Cells covered by text
+	settled   [][]float64 // Snow on top of text per cell, 0 to 1
+	ground    []float64   // Height of the drift on the ground per column, in rows
+	frame     int
+}
+
+// SnowConfig holds configuration for the snow effect
+type SnowConfig struct {
+	Width   int
+	Height  int
+	Palette []string // Colors from the farthest flakes to the nearest, the last also used for settled snow
+
+	Layers   int     // Depth layers, farther ones smaller, slower and dimmer, default 3
+	Density  float64 // Flakes per screen cell, default 0.05
+	MeltRate float64 // Snow melted from each exposed cell per frame, default 0.0003
+	MaxDrift int     // Tallest drift on the ground in rows, default a quarter of the screen
+
+	Text      string // Optional text that snow settles on, centered on screen
+	TextColor string // Default white
+}
+
+// snowGlyphs are the flake characters per depth, from nearest to farthest
+var snowGlyphs = [][]rune{
+	{'❄', '*'},
+	{'*', '•'},
+	{'•', '·'},
+	{'·'},
+}
+
+// snowDrift draws settled snow from a dusting to a full cell
+var snowDrift = []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// NewSnowEffect creates a new snow effect with given dimensions and theme palette
+func NewSnowEffect(width, height int, palette []string) *SnowEffect {
+	return NewSnowEffectWithConfig(SnowConfig{
+		Width:   width,
+		Height:  height,
+		Palette: palette,
+	})
+}
+
+// NewSnowEffectWithConfig creates a snow effect with custom depth, density
+// and melting, settling on optional text
+func NewSnowEffectWithConfig(config SnowConfig) *SnowEffect {
+	if config.Layers == 0 {
+		config.Layers = 3
+	}
+	if config.Density == 0 {
+		config.Density = 0.05
+	}
+	if config.MeltRate == 0 {
+		config.MeltRate = 0.0003
+	}
+	if config.MaxDrift == 0 {
+		config.MaxDrift = max(config.Height/4, 1)
+	}
+	if config.TextColor == "" {
+		config.TextColor = "#ffffff"
+	}
+
+	s := &SnowEffect{
+		width:     config.Width,
+		height:    config.Height,
+		palette:   config.Palette,
+		layers:    config.Layers,
+		density:   config.Density,
+		maxFlakes: int(float64(config.Width*config.Height) * config.Density),
+		meltRate:  config.MeltRate,
+		maxDrift:  config.MaxDrift,
+		textColor: config.TextColor,
+	}
+	s.dropField = dropField{
+		drops: make([]RainDrop, 0, 200),
+		spawn: s.newFlake,
+		drift: s.sway,
+		land:  s.landFlake,
+	}
+	if strings.TrimSpace(config.Text) != "" {
+		s.text = strings.Split(config.Text, "\n")
+	}
+	s.init()
+	return s
+}
+
+// init lays out the text and scatters the first flakes over the screen
+func (s *SnowEffect) init() {
+	s.solid = make([][]bool, s.height)
+	s.settled = make([][]float64, s.height)
+	for y := range s.solid {
+		s.solid[y] = make([]bool, s.width)
+		s.settled[y] = make([]float64, s.width)
+	}
+	s.ground = make([]float64, s.width)
+
+	top := (s.height - len(s.text)) / 2
+	for i, line := range s.text {
+		runes := []rune(line)
+		y := top + i
+		left := (s.width - len(runes)) / 2
+		for j, r := range runes {
+			x := left + j
+			if r != ' ' && y >= 0 && y < s.height && x >= 0 && x < s.width {
+				s.solid[y][x] = true
+			}
+		}
+	}
+
+	s.drops = s.drops[:0]
+	for len(s.drops) < s.maxFlakes {
+		s.drops = append(s.drops, s.newFlake(rand.Intn(max(s.height, 1))))
+	}
+}
+
+// newFlake creates a flake at row y in a random column and layer
+func (s *SnowEffect) newFlake(y int) RainDrop {
+	depth := rand.Intn(s.layers)
+	glyphs := snowGlyphs[min(depth*len(snowGlyphs)/s.layers, len(snowGlyphs)-1)]
+
+	// Nearer flakes fall faster and sway wider
+	nearness := 1 - float64(depth)/float64(s.layers)
+	return RainDrop{
+		X:     rand.Intn(max(s.width, 1)),
+		Y:     y,
+		Speed: (0.08 + rand.Float64()*0.08) * (0.5 + nearness),
+		Char:  glyphs[rand.Intn(len(glyphs))],
+		Color: s.layerColor(depth),
+		Depth: depth,
+		Sway:  (0.5 + rand.Float64()) * nearness,
+		Phase: rand.Float64() * 2 * math.Pi,
+	}
+}
+
+// UpdatePalette changes the snow color palette (for theme switching)
+func (s *SnowEffect) UpdatePalette(palette []string) {
+	s.palette = palette
+}
+
+// Resize reinitializes the snow effect with new dimensions
+func (s *SnowEffect) Resize(width, height int) {
+	s.width = width
+	s.height = height
+	s.maxFlakes = int(float64(width*height) * s.density)
+	s.init()
+}
+
+// sway returns how far a flake moves sideways this frame, swinging it
+// back and forth around where it started
+func (s *SnowEffect) sway(f RainDrop) float64 {
+	return f.Sway * 0.08 * math.Cos(f.Phase+float64(s.frame)*0.08)
+}
+
+// blocked reports whether a flake can't fall into a cell
+func (s *SnowEffect) blocked(x, y int) bool {
+	return y >= s.height-int(s.ground[x]) || s.solid[y][x]
+}
+
+// landFlake reports whether a flake landed. The nearer half of the layers
+// settles or melts on landing, farther flakes fall behind the scene.
+func (s *SnowEffect) landFlake(from, to RainDrop) bool {
+	near := to.Depth < (s.layers+1)/2
+	if near && from.Y >= 0 && from.Y < s.height && to.Y > from.Y && s.blocked(from.X, to.Y) {
+		s.settle(from.X, from.Y)
+		return true
+	}
+	return to.Y >= s.height
+}
+
+// Update advances the snow simulation by one frame
+func (s *SnowEffect) Update() {
+	s.frame++
+	s.fall(s.width, s.maxFlakes)
+	s.fill(s.maxFlakes, 0.3)
+	s.melt()
+}
+
+// settle adds a landed flake to the snow at a cell. Text holds a single row
+// of snow and drifts on the ground stop growing at maxDrift.
+func (s *SnowEffect) settle(x, y int) {
+	const flake = 0.25 // Snow a flake adds, in rows
+
+	if y+1 < s.height && s.solid[y+1][x] {
+		s.settled[y][x] = math.Min(s.settled[y][x]+flake, 1)
+		return
+	}
+
+	// Snow slides off the steep side of a drift
+	for _, dx := range rand.Perm(2) {
+		side := x + dx*2 - 1
+		if side >= 0 && side < s.width && s.ground[side] < s.ground[x]-1 {
+			x = side
+			break
+		}
+	}
+	s.ground[x] = math.Min(s.ground[x]+flake, float64(s.maxDrift))
+}
+
+// melt shrinks the snow on the ground and on the text
+func (s *SnowEffect) melt() {
+	for x := range s.ground {
+		s.ground[x] = math.Max(s.ground[x]-s.meltRate, 0)
+	}
+	for y := range s.settled {
+		for x := range s.settled[y] {
+			s.settled[y][x] = math.Max(s.settled[y][x]-s.meltRate, 0)
+		}
+	}
+}
+
+// driftGlyph returns the character of a cell filled with snow up to level
+func driftGlyph(level float64) rune {
+	return snowDrift[int(level*float64(len(snowDrift)-1))]
+}
+
+// layerColor returns the color of a depth layer, dimmer for distant layers
+func (s *SnowEffect) layerColor(depth int) string {
+	if len(s.palette) == 0 {
+		return "#ffffff" // Default white if no palette
+	}
+	nearness := float64(s.layers-1-depth) / float64(max(s.layers-1, 1))
+	return s.palette[int(nearness*float64(len(s.palette)-1)+0.5)]
+}
+
+// Render converts the flakes, drifts and text to colored text output
+func (s *SnowEffect) Render() string {
+	// Create empty canvas
+	canvas := make([][]rune, s.height)
+	colors := make([][]string, s.height)
+	for i := range canvas {
+		canvas[i] = make([]rune, s.width)
+		colors[i] = make([]string, s.width)
+		for j := range canvas[i] {
+			canvas[i][j] = ' '
+		}
+	}
+
+	// Farthest flakes first so nearer ones are drawn over them
+	for depth := s.layers - 1; depth >= 0; depth-- {
+		for _, f := range s.drops {
+			if f.Depth != depth || f.Y < 0 || f.Y >= s.height || f.X >= s.width {
+				continue
+			}
+			canvas[f.Y][f.X] = f.Char
+			colors[f.Y][f.X] = f.Color
+		}
+	}
+
+	snowColor := s.layerColor(0)
+	top := (s.height - len(s.text)) / 2
+	for y := 0; y < s.height; y++ {
+		for x := 0; x < s.width; x++ {
+			switch {
+			case s.solid[y][x]:
+				line := []rune(s.text[y-top])
+				canvas[y][x] = line[x-(s.width-len(line))/2]
+				colors[y][x] = s.textColor
+			case s.settled[y][x] > 0:
+				canvas[y][x] = driftGlyph(s.settled[y][x])
+				colors[y][x] = snowColor
+			case y >= s.height-int(math.Ceil(s.ground[x])):
+				// Full rows below the partly filled top of the drift
+				canvas[y][x] = driftGlyph(math.Min(s.ground[x]-float64(s.height-1-y), 1))
+				colors[y][x] = snowColor
+			}
+		}
+	}
+
+	// Convert to colored string
+	var lines []string
+	for y := 0; y < s.height; y++ {
+		var line strings.Builder
+		for x := 0; x < s.width; x++ {
+			char := canvas[y][x]
+			if char != ' ' && colors[y][x] != "" {
+				// Render colored character
+				styled := lipgloss.NewStyle().
+					Foreground(lipgloss.Color(colors[y][x])).
+					Render(string(char))
+				line.WriteString(styled)
+			} else {
+				line.WriteRune(char)
+			}
+		}
+		lines = append(lines, line.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Reset restarts the animation from the beginning
+func (s *SnowEffect) Reset() {
+	s.frame = 0
+	s.init()
+}
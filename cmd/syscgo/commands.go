@@ -0,0 +1,215 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// command is a syscgo subcommand. setup registers the command's flags on fs
+// and returns the function that runs it with the remaining arguments.
+type command struct {
+	name    string
+	args    string // Positional arguments shown in usage, e.g. <effect>
+	summary string
+	details string // Extra help text shown by <command> -h
+	hidden  bool   // Left out of help, e.g. the completion helper
+	setup   func(fs *flag.FlagSet) func(args []string)
+}
+
+// commands lists every subcommand in the order shown by help. It is filled in
+// by init because completion refers back to it.
+var commands []command
+
+func init() {
+	commands = []command{
+		{
+			name:    "run",
+			args:    "<effect>",
+			summary: "Play an effect in the terminal",
+			details: "Text effects (" + strings.Join(textEffectNames(true), ", ") + ") read their text from -text, -file\n" +
+				"or piped stdin and fall back on demo text.\n\n" +
+				"Text is optional for " + strings.Join(textEffectNames(false), ", ") + ", which only read\n" +
+				"stdin with -file -: beams without text sweeps the whole screen, fire burns\n" +
+				"the text, matrix hides it in the rain, snow settles on it and fireworks\n" +
+				"spell it out. The other effects take no text.",
+			setup: runFlags,
+		},
+		{
+			name:    "preview",
+			args:    "[effect...]",
+			summary: "Play each effect for a few seconds, all of them by default",
+			setup:   previewFlags,
+		},
+		{
+			name:    "export",
+			args:    "<effect>",
+			summary: "Render an effect to an ANSI or asciicast file",
+			details: "ansi files replay with cat or pv -L; asciicast files with asciinema play.\n" +
+				"Text effects stop exporting once their text is complete.",
+			setup: exportFlags,
+		},
+		{
+			name:    "list",
+			summary: "List effects, their parameters and themes",
+			setup:   listFlags,
+		},
+		{
+			name:    "themes",
+			args:    "[show <name>]",
+			summary: "List color themes, preview them side by side or show their colors",
+			details: "themes -preview plays -effect once per theme in a grid. themes show <name>\n" +
+				"prints a swatch of every color role of a theme.\n\n" +
+				"User themes are loaded from " + userThemeDir() + "/<name>" + userThemeExt,
+			setup: themesFlags,
+		},
+		{
+			name:    "describe",
+			args:    "<effect>",
+			summary: "Show every parameter of an effect with its type, default and valid range",
+			setup:   describeFlags,
+		},
+		{
+			name:    "completion",
+			args:    "bash|zsh|fish",
+			summary: "Print a shell completion script",
+			setup:   completionFlags,
+		},
+		{
+			name:   "__complete",
+			hidden: true,
+			setup:  completeFlags,
+		},
+	}
+}
+
+// findCommand looks up a subcommand by name
+func findCommand(name string) (command, bool) {
+	for _, c := range commands {
+		if c.name == name {
+			return c, true
+		}
+	}
+	return command{}, false
+}
+
+// newFlagSet creates the flag set of a command with generated help
+func newFlagSet(c command) *flag.FlagSet {
+	fs := flag.NewFlagSet(c.name, flag.ContinueOnError)
+	fs.Usage = func() {
+		out := fs.Output()
+		fmt.Fprintf(out, "Usage: syscgo %s", c.name)
+		if c.args != "" {
+			fmt.Fprintf(out, " %s", c.args)
+		}
+		fmt.Fprintf(out, " [flags]\n\n%s\n", c.summary)
+		if c.details != "" {
+			fmt.Fprintf(out, "\n%s\n", c.details)
+		}
+		hasFlags := false
+		fs.VisitAll(func(*flag.Flag) { hasFlags = true })
+		if hasFlags {
+			fmt.Fprintln(out, "\nFlags:")
+			fs.PrintDefaults()
+		}
+	}
+	return fs
+}
+
+// parseArgs parses flags that may appear before, between or after the
+// positional arguments, which it returns
+func parseArgs(fs *flag.FlagSet, args []string) []string {
+	fs.SetOutput(io.Discard)
+
+	var positional []string
+	for {
+		err := fs.Parse(args)
+		if errors.Is(err, flag.ErrHelp) {
+			fs.SetOutput(os.Stdout)
+			fs.Usage()
+			os.Exit(exitOK)
+		}
+		if err != nil {
+			fail(exitUsage, "%v (run 'syscgo %s -h' for usage)", err, fs.Name())
+		}
+
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// runCommand parses the arguments of a command and runs it
+func runCommand(c command, args []string) {
+	fs := newFlagSet(c)
+	run := c.setup(fs)
+	run(parseArgs(fs, args))
+}
+
+// flagWasSet reports whether a flag was given on the command line
+func flagWasSet(fs *flag.FlagSet, name string) bool {
+	set := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+// mustFindEffect looks up an effect or exits with exitUnknownEffect
+func mustFindEffect(name string) effectDef {
+	e, ok := findEffect(name)
+	if !ok {
+		fail(exitUnknownEffect, "%q (available: %s)", name, strings.Join(effectNames(), ", "))
+	}
+	return e
+}
+
+func listFlags(fs *flag.FlagSet) func(args []string) {
+	asJSON := fs.Bool("json", false, "Print effects, parameters and themes as JSON")
+	theme := fs.String("theme", "dracula", "Theme the parameter defaults are shown for")
+
+	return func(args []string) {
+		if len(args) > 0 {
+			fail(exitUsage, "list takes no arguments")
+		}
+		width, height, _ := terminalSize()
+		listEffects(*asJSON, width, height, resolveTheme(*theme))
+	}
+}
+
+func describeFlags(fs *flag.FlagSet) func(args []string) {
+	theme := fs.String("theme", "dracula", "Theme the parameter defaults are shown for")
+
+	return func(args []string) {
+		if len(args) != 1 {
+			fail(exitUsage, "usage: syscgo describe <effect>")
+		}
+		width, height, _ := terminalSize()
+		describeEffect(mustFindEffect(args[0]), width, height, resolveTheme(*theme))
+	}
+}
+
+func completionFlags(fs *flag.FlagSet) func(args []string) {
+	return func(args []string) {
+		if len(args) != 1 {
+			fail(exitUsage, "usage: syscgo completion bash|zsh|fish")
+		}
+		script, err := completionScript(args[0])
+		if err != nil {
+			fail(exitUsage, "%v", err)
+		}
+		fmt.Print(script)
+	}
+}
+
+func completeFlags(fs *flag.FlagSet) func(args []string) {
+	return completeCandidates
+}
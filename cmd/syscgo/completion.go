@@ -0,0 +1,329 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// valueFlags are flags whose values the scripts complete, by kind
+var valueFlags = map[string]string{
+	"effect": "effects",
+	"theme":  "themes",
+	"file":   "files",
+	"o":      "files",
+	"set":    "params",
+	"format": "formats",
+}
+
+// completeCandidates prints completion candidates for the generated scripts,
+// one per line. Themes and parameters are looked up on every call so user
+// theme files added later are picked up without regenerating the script.
+func completeCandidates(args []string) {
+	if len(args) == 0 {
+		return
+	}
+
+	switch args[0] {
+	case "effects":
+		for _, name := range effectNames() {
+			fmt.Println(name)
+		}
+	case "themes":
+		for _, name := range themeNames() {
+			fmt.Println(name)
+		}
+	case "params":
+		if len(args) < 2 {
+			return
+		}
+		e, ok := findEffect(args[1])
+		if !ok || e.config == nil {
+			return
+		}
+		for _, p := range effectParams(e.name, e.config(80, 24, e.frameDelay, "dracula", e.defaultText)) {
+			fmt.Println(p.name + "=")
+		}
+	}
+}
+
+// completionFlag is a flag of a command as listed by the scripts
+type completionFlag struct {
+	name, usage string
+	isBool      bool
+}
+
+// commandFlags returns the flags of a command in name order
+func commandFlags(c command) []completionFlag {
+	fs := newFlagSet(c)
+	c.setup(fs)
+
+	var flags []completionFlag
+	fs.VisitAll(func(f *flag.Flag) {
+		b, ok := f.Value.(interface{ IsBoolFlag() bool })
+		flags = append(flags, completionFlag{
+			name:   f.Name,
+			usage:  strings.ReplaceAll(f.Usage, "`", ""),
+			isBool: ok && b.IsBoolFlag(),
+		})
+	})
+	sort.Slice(flags, func(i, j int) bool { return flags[i].name < flags[j].name })
+	return flags
+}
+
+// shellQuote quotes s for a single-quoted shell string
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// completionScript returns the completion script for a shell, generated from
+// the command table so it lists the same commands and flags as the help
+func completionScript(shell string) (string, error) {
+	var script string
+	switch shell {
+	case "bash":
+		script = bashCompletion
+	case "zsh":
+		script = zshCompletion
+	case "fish":
+		script = fishCompletion
+	default:
+		return "", fmt.Errorf("unsupported shell %q (supported: bash, zsh, fish)", shell)
+	}
+
+	var names, effectCommands []string
+	var bashFlags, zshFlags, fishFlags []string
+	otherValueFlags := map[string]bool{}
+	for _, c := range commands {
+		if c.hidden {
+			continue
+		}
+		names = append(names, c.name)
+		if strings.Contains(c.args, "effect") {
+			effectCommands = append(effectCommands, c.name)
+		}
+
+		var flagNames, zshEntries []string
+		for _, f := range commandFlags(c) {
+			flagNames = append(flagNames, "-"+f.name)
+			zshEntries = append(zshEntries, shellQuote("-"+f.name+":"+strings.ReplaceAll(f.usage, ":", `\:`)))
+			if !f.isBool && valueFlags[f.name] == "" {
+				otherValueFlags[f.name] = true
+			}
+
+			fish := fmt.Sprintf("complete -c syscgo -n '__syscgo_using %s' -o %s", c.name, f.name)
+			switch valueFlags[f.name] {
+			case "effects":
+				fish += " -x -a '(syscgo __complete effects)'"
+			case "themes":
+				fish += " -x -a '(syscgo __complete themes)'"
+			case "files":
+				fish += " -r -F"
+			case "params":
+				fish += " -x -a '(syscgo __complete params (__syscgo_effect))'"
+			case "formats":
+				fish += " -x -a " + shellQuote(strings.Join(exportFormats, " "))
+			default:
+				if !f.isBool {
+					fish += " -x"
+				}
+			}
+			fishFlags = append(fishFlags, fish+" -d "+shellQuote(f.usage))
+		}
+
+		bashFlags = append(bashFlags, fmt.Sprintf("            %s) COMPREPLY=($(compgen -W %s -- \"$cur\")) ;;",
+			c.name, shellQuote(strings.Join(flagNames, " "))))
+		zshFlags = append(zshFlags, fmt.Sprintf("    %s) flags=(%s) ;;", c.name, strings.Join(zshEntries, " ")))
+	}
+
+	var skip []string
+	for name := range otherValueFlags {
+		skip = append(skip, "-"+name, "--"+name)
+	}
+	sort.Strings(skip)
+
+	return strings.NewReplacer(
+		"{{commands}}", strings.Join(names, " "),
+		"{{effect_commands}}", strings.Join(effectCommands, "|"),
+		"{{effect_command_list}}", strings.Join(effectCommands, " "),
+		"{{formats}}", strings.Join(exportFormats, " "),
+		"{{value_flags}}", strings.Join(skip, "|"),
+		"{{bash_flags}}", strings.Join(bashFlags, "\n"),
+		"{{zsh_flags}}", strings.Join(zshFlags, "\n"),
+		"{{fish_flags}}", strings.Join(fishFlags, "\n"),
+	).Replace(script), nil
+}
+
+const bashCompletion = `# bash completion for syscgo
+# Install with: syscgo completion bash > /etc/bash_completion.d/syscgo
+_syscgo() {
+    local cur="${COMP_WORDS[COMP_CWORD]}"
+    local prev="${COMP_WORDS[COMP_CWORD-1]}"
+    local cmd=run effect=fire i
+
+    # Without a command, flags belong to the older syscgo -effect form of run
+    if [[ $COMP_CWORD -gt 1 && ${COMP_WORDS[1]} != -* ]]; then
+        cmd="${COMP_WORDS[1]}"
+        if [[ ${COMP_WORDS[2]} != -* ]]; then
+            effect="${COMP_WORDS[2]}"
+        fi
+    fi
+    for ((i = 1; i < COMP_CWORD - 1; i++)); do
+        if [[ ${COMP_WORDS[i]} == -effect || ${COMP_WORDS[i]} == --effect ]]; then
+            effect="${COMP_WORDS[i+1]}"
+        fi
+    done
+
+    case "$prev" in
+        -effect|--effect)
+            COMPREPLY=($(compgen -W "$(syscgo __complete effects 2>/dev/null)" -- "$cur"))
+            return ;;
+        -theme|--theme)
+            COMPREPLY=($(compgen -W "$(syscgo __complete themes 2>/dev/null)" -- "$cur"))
+            return ;;
+        -file|--file|-o|--o)
+            COMPREPLY=($(compgen -f -- "$cur"))
+            return ;;
+        -set|--set)
+            compopt -o nospace
+            COMPREPLY=($(compgen -W "$(syscgo __complete params "$effect" 2>/dev/null)" -- "$cur"))
+            return ;;
+        -format|--format)
+            COMPREPLY=($(compgen -W "{{formats}}" -- "$cur"))
+            return ;;
+        {{value_flags}})
+            return ;;
+    esac
+
+    if [[ $cur == -* ]]; then
+        case "$cmd" in
+{{bash_flags}}
+        esac
+    elif [[ $COMP_CWORD -eq 1 ]]; then
+        COMPREPLY=($(compgen -W "{{commands}}" -- "$cur"))
+    else
+        case "$cmd" in
+            {{effect_commands}})
+                COMPREPLY=($(compgen -W "$(syscgo __complete effects 2>/dev/null)" -- "$cur")) ;;
+            themes)
+                if [[ $prev == show ]]; then
+                    COMPREPLY=($(compgen -W "$(syscgo __complete themes 2>/dev/null)" -- "$cur"))
+                else
+                    COMPREPLY=($(compgen -W "show" -- "$cur"))
+                fi ;;
+            completion)
+                COMPREPLY=($(compgen -W "bash zsh fish" -- "$cur")) ;;
+        esac
+    fi
+}
+complete -o filenames -F _syscgo syscgo
+`
+
+const zshCompletion = `#compdef syscgo
+# zsh completion for syscgo
+# Install with: syscgo completion zsh > "${fpath[1]}/_syscgo"
+_syscgo() {
+  local -a flags
+  local cmd=run effect=fire i
+
+  # Without a command, flags belong to the older syscgo -effect form of run
+  if (( CURRENT > 2 )) && [[ ${words[2]} != -* ]]; then
+    cmd=${words[2]}
+    if [[ ${words[3]} != -* ]]; then
+      effect=${words[3]}
+    fi
+  fi
+  for (( i = 2; i < CURRENT - 1; i++ )); do
+    if [[ ${words[i]} == -effect || ${words[i]} == --effect ]]; then
+      effect=${words[i+1]}
+    fi
+  done
+
+  case ${words[CURRENT-1]} in
+    -effect|--effect)
+      compadd -- ${(f)"$(syscgo __complete effects 2>/dev/null)"}
+      return ;;
+    -theme|--theme)
+      compadd -- ${(f)"$(syscgo __complete themes 2>/dev/null)"}
+      return ;;
+    -file|--file|-o|--o)
+      _files
+      return ;;
+    -set|--set)
+      compadd -S '' -- ${(f)"$(syscgo __complete params $effect 2>/dev/null)"}
+      return ;;
+    -format|--format)
+      compadd {{formats}}
+      return ;;
+    {{value_flags}})
+      return ;;
+  esac
+
+  case $cmd in
+{{zsh_flags}}
+  esac
+
+  if [[ $PREFIX == -* ]]; then
+    _describe 'flag' flags
+  elif (( CURRENT == 2 )); then
+    compadd {{commands}}
+  else
+    case $cmd in
+      {{effect_commands}})
+        compadd -- ${(f)"$(syscgo __complete effects 2>/dev/null)"} ;;
+      themes)
+        if [[ ${words[CURRENT-1]} == show ]]; then
+          compadd -- ${(f)"$(syscgo __complete themes 2>/dev/null)"}
+        else
+          compadd show
+        fi ;;
+      completion)
+        compadd bash zsh fish ;;
+    esac
+  fi
+}
+
+_syscgo "$@"
+`
+
+const fishCompletion = `# fish completion for syscgo
+# Install with: syscgo completion fish > ~/.config/fish/completions/syscgo.fish
+
+# The command being completed; flags without one belong to the older
+# syscgo -effect form of run
+function __syscgo_command
+    set -l tokens (commandline -opc)
+    if test (count $tokens) -ge 2; and not string match -q -- '-*' $tokens[2]
+        echo $tokens[2]
+    else
+        echo run
+    end
+end
+
+function __syscgo_using
+    test (__syscgo_command) = $argv[1]
+end
+
+function __syscgo_effect
+    set -l tokens (commandline -opc)
+    set -l effect fire
+    if test (count $tokens) -ge 3; and not string match -q -- '-*' $tokens[3]
+        set effect $tokens[3]
+    end
+    for i in (seq (math (count $tokens) - 1))
+        if contains -- $tokens[$i] -effect --effect
+            set effect $tokens[(math $i + 1)]
+        end
+    end
+    echo $effect
+end
+
+complete -c syscgo -f
+complete -c syscgo -n __fish_use_subcommand -a '{{commands}}'
+complete -c syscgo -n '__fish_seen_subcommand_from {{effect_command_list}}' -a '(syscgo __complete effects)'
+complete -c syscgo -n '__fish_seen_subcommand_from completion' -a 'bash zsh fish'
+complete -c syscgo -n '__fish_seen_subcommand_from themes; and not __fish_seen_subcommand_from show' -a show
+complete -c syscgo -n '__fish_seen_subcommand_from show' -a '(syscgo __complete themes)'
+{{fish_flags}}
+`
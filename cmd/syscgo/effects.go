@@ -0,0 +1,660 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Nomadcxx/sysc-Go/animations"
+)
+
+// animator is the subset of the animation API the CLI needs to drive an effect
+type animator interface {
+	Update()
+	Render() string
+}
+
+// effectDef describes an effect the CLI knows how to run
+type effectDef struct {
+	name        string
+	summary     string
+	takesText   bool          // Effect renders text supplied with -file
+	defaultText string        // Text used when no file is given
+	frameDelay  time.Duration // Delay between frames
+
+	// config returns a pointer to the effect's config struct populated with
+	// the CLI defaults, or nil if the effect has no tunable parameters.
+	// frameDelay is the effect's own, for effects that keep time in frames.
+	config func(width, height int, frameDelay time.Duration, theme, text string) any
+
+	// build constructs the effect from the value returned by config
+	build func(width, height int, theme string, config any) animator
+}
+
+// effects lists every effect in the order shown by help and list
+var effects = []effectDef{
+	{
+		name:       "fire",
+		summary:    "DOOM PSX-style fire, burning text when given",
+		takesText:  true,
+		frameDelay: 50 * time.Millisecond,
+		config:     fireConfig,
+		build: func(_, _ int, _ string, config any) animator {
+			return animations.NewFireEffectWithConfig(*config.(*animations.FireConfig))
+		},
+	},
+	{
+		name:       "matrix",
+		summary:    "Matrix digital rain, revealing text when given",
+		takesText:  true,
+		frameDelay: 50 * time.Millisecond,
+		config:     matrixConfig,
+		build: func(_, _ int, _ string, config any) animator {
+			return animations.NewMatrixEffectWithConfig(*config.(*animations.MatrixConfig))
+		},
+	},
+	{
+		name:       "rain",
+		summary:    "ASCII character rain, with wind, splashes and lightning in weather mode",
+		frameDelay: 50 * time.Millisecond,
+		config:     rainConfig,
+		build: func(_, _ int, _ string, config any) animator {
+			return animations.NewRainEffectWithConfig(*config.(*animations.RainConfig))
+		},
+	},
+	{
+		name:       "snow",
+		summary:    "Snowfall that drifts along the bottom and settles on text when given",
+		takesText:  true,
+		frameDelay: 50 * time.Millisecond,
+		config:     snowConfig,
+		build: func(_, _ int, _ string, config any) animator {
+			return animations.NewSnowEffectWithConfig(*config.(*animations.SnowConfig))
+		},
+	},
+	{
+		name:       "fireworks",
+		summary:    "Particle fireworks display, with a finale spelling out text when given",
+		takesText:  true,
+		frameDelay: 50 * time.Millisecond,
+		config:     fireworksConfig,
+		build: func(_, _ int, _ string, config any) animator {
+			return animations.NewFireworksEffectWithConfig(*config.(*animations.FireworksConfig))
+		},
+	},
+	{
+		name:        "decrypt",
+		summary:     "Movie-style text decryption",
+		takesText:   true,
+		defaultText: "DECRYPT ME",
+		frameDelay:  50 * time.Millisecond,
+		config:      decryptConfig,
+		build: func(_, _ int, _ string, config any) animator {
+			return animations.NewDecryptEffect(*config.(*animations.DecryptConfig))
+		},
+	},
+	{
+		name:        "pour",
+		summary:     "Characters pour into place",
+		takesText:   true,
+		defaultText: "POUR EFFECT\nDEMO TEXT\nTHIRD LINE",
+		frameDelay:  50 * time.Millisecond,
+		config:      pourConfig,
+		build: func(_, _ int, _ string, config any) animator {
+			return animations.NewPourEffect(*config.(*animations.PourConfig))
+		},
+	},
+	{
+		name:        "print",
+		summary:     "Typewriter-style printing",
+		takesText:   true,
+		defaultText: "PRINT EFFECT\nDEMO TEXT\nTHIRD LINE",
+		frameDelay:  30 * time.Millisecond,
+		config:      printConfig,
+		build: func(_, _ int, _ string, config any) animator {
+			return animations.NewPrintEffect(*config.(*animations.PrintConfig))
+		},
+	},
+	{
+		name:       "beams",
+		summary:    "Light beams sweeping across text or the screen",
+		takesText:  true,
+		frameDelay: 50 * time.Millisecond,
+		config:     beamsConfig,
+		build: func(_, _ int, _ string, config any) animator {
+			return animations.NewBeamsEffect(*config.(*animations.BeamsConfig))
+		},
+	},
+	{
+		name:       "aquarium",
+		summary:    "Underwater scene with fish, divers and boats",
+		frameDelay: 50 * time.Millisecond,
+		config:     aquariumConfig,
+		build: func(_, _ int, _ string, config any) animator {
+			return animations.NewAquariumEffect(*config.(*animations.AquariumConfig))
+		},
+	},
+}
+
+// findEffect looks up an effect by name
+func findEffect(name string) (effectDef, bool) {
+	for _, e := range effects {
+		if e.name == name {
+			return e, true
+		}
+	}
+	return effectDef{}, false
+}
+
+// effectNames returns the names of all effects
+func effectNames() []string {
+	names := make([]string, len(effects))
+	for i, e := range effects {
+		names[i] = e.name
+	}
+	return names
+}
+
+// textEffectNames returns the names of the effects that take text, either
+// those that fall back on demo text or those where text is optional
+func textEffectNames(withDemoText bool) []string {
+	var names []string
+	for _, e := range effects {
+		if e.takesText && (e.defaultText != "") == withDemoText {
+			names = append(names, e.name)
+		}
+	}
+	return names
+}
+
+// completer is implemented by effects that reach a final state
+type completer interface {
+	IsComplete() bool
+}
+
+// playOptions controls how play drives an effect
+type playOptions struct {
+	frames    int           // Frame budget, 0 = infinite
+	delay     time.Duration // Delay between frames
+	input     <-chan string // Lines to append to the effect (follow mode)
+	wrapWidth int           // Width appended lines are wrapped to
+	once      bool          // Stop as soon as the effect completes
+	label     string        // Caption shown in reverse video on labelRow (preview)
+	labelRow  int           // 1-based row of the label
+	plain     bool          // Print frames one after another without cursor control
+	delimiter string        // Line printed after each frame in plain mode
+}
+
+// play drives an effect until the frame budget runs out or, with once set,
+// the effect completes. It returns the last rendered frame.
+func play(anim animator, opts playOptions) string {
+	input := opts.input
+	output := ""
+	frame := 0
+	for opts.frames == 0 || frame < opts.frames {
+		// Append whatever arrived since the last frame
+		for pending := true; pending && input != nil; {
+			select {
+			case line, ok := <-input:
+				if !ok {
+					input = nil
+					break
+				}
+				anim.(appender).AppendText(wrapText(line, opts.wrapWidth))
+			default:
+				pending = false
+			}
+		}
+
+		anim.Update()
+		output = anim.Render()
+
+		if opts.plain {
+			fmt.Printf("%s\n%s\n", output, opts.delimiter)
+		} else {
+			fmt.Print("\033[H") // Move cursor to top
+			fmt.Print(output)
+		}
+		if opts.label != "" {
+			fmt.Printf("\033[%d;1H\033[7m %s \033[0m", opts.labelRow, opts.label)
+		}
+
+		if opts.once && anim.(completer).IsComplete() {
+			break
+		}
+		time.Sleep(opts.delay)
+		frame++
+	}
+	return output
+}
+
+// lastContentRow returns the index of the last non-blank line of a frame
+func lastContentRow(frame string) int {
+	lines := strings.Split(frame, "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		if strings.TrimSpace(ansiPattern.ReplaceAllString(lines[i], "")) != "" {
+			return i
+		}
+	}
+	return 0
+}
+
+var ansiPattern = regexp.MustCompile(`\x1b\[[0-9;?]*[A-Za-z]`)
+
+func fireConfig(width, height int, _ time.Duration, theme, text string) any {
+	config := &animations.FireConfig{
+		Width:   width,
+		Height:  height,
+		Palette: animations.GetFirePalette(theme),
+		Source:  "bottom",
+		Wind:    -0.5,
+
+		DecayProfile: "classic",
+		Chars:        []rune{' ', '░', '▒', '▓', '█'},
+	}
+
+	// Text is set on fire by making its glyphs the heat source
+	if text != "" {
+		config.Source = "mask"
+		config.SourceMask = strings.Split(text, "\n")
+	}
+	return config
+}
+
+func matrixConfig(width, height int, _ time.Duration, theme, text string) any {
+	return &animations.MatrixConfig{
+		Width:       width,
+		Height:      height,
+		Palette:     animations.GetMatrixPalette(theme),
+		GlyphSet:    "classic",
+		Density:     0.02,
+		SpeedRange:  [2]int{1, 3},
+		LengthRange: [2]int{5, 20},
+		Flicker:     0.05,
+		Message:     text,
+	}
+}
+
+func rainConfig(width, height int, _ time.Duration, theme, _ string) any {
+	palette := animations.GetRainPalette(theme)
+	return &animations.RainConfig{
+		Width:           width,
+		Height:          height,
+		Palette:         palette,
+		IntensityPeriod: 600,
+		LightningChance: 0.01,
+		LightningColor:  palette[0],
+	}
+}
+
+func snowConfig(width, height int, _ time.Duration, theme, text string) any {
+	palette := animations.GetSnowPalette(theme)
+	return &animations.SnowConfig{
+		Width:     width,
+		Height:    height,
+		Palette:   palette,
+		Layers:    3,
+		Density:   0.05,
+		MeltRate:  0.0003,
+		MaxDrift:  max(height/4, 1),
+		Text:      text,
+		TextColor: palette[len(palette)-1],
+	}
+}
+
+func fireworksConfig(width, height int, _ time.Duration, theme, text string) any {
+	weights := make(map[string]float64)
+	for _, shellType := range animations.FireworksShellTypes {
+		weights[shellType] = 1
+	}
+	return &animations.FireworksConfig{
+		Width:        width,
+		Height:       height,
+		Palette:      animations.GetFireworksPalette(theme),
+		Mode:         "bezier",
+		Gravity:      0.03,
+		Drag:         0.05,
+		ShellWeights: weights,
+		Text:         text,
+		TextHold:     40,
+		MaxShells:    max(width/15, 6),
+		MaxParticles: 0, // Enough for MaxShells shells and the text
+	}
+}
+
+func pourConfig(width, height int, _ time.Duration, theme, text string) any {
+	// Get theme colors for pour effect
+	var gradientStops []string
+
+	switch theme {
+	case "dracula":
+		gradientStops = []string{"#ff79c6", "#bd93f9", "#ffffff"}
+	case "gruvbox":
+		gradientStops = []string{"#fe8019", "#fabd2f", "#ffffff"}
+	case "nord":
+		gradientStops = []string{"#88c0d0", "#81a1c1", "#ffffff"}
+	case "tokyo-night":
+		gradientStops = []string{"#9ece6a", "#e0af68", "#ffffff"}
+	case "catppuccin":
+		gradientStops = []string{"#cba6f7", "#f5c2e7", "#ffffff"}
+	case "material":
+		gradientStops = []string{"#03dac6", "#bb86fc", "#ffffff"}
+	case "solarized":
+		gradientStops = []string{"#268bd2", "#2aa198", "#ffffff"}
+	case "monochrome":
+		gradientStops = []string{"#808080", "#c0c0c0", "#ffffff"}
+	case "transishardjob":
+		gradientStops = []string{"#55cdfc", "#f7a8b8", "#ffffff"}
+	default:
+		gradientStops = []string{"#8A008A", "#00D1FF", "#FFFFFF"}
+	}
+
+	return &animations.PourConfig{
+		Width:                  width,
+		Height:                 height,
+		Text:                   text,
+		PourDirection:          "down",
+		PourSpeed:              3,
+		MovementSpeed:          0.2,
+		Gap:                    1,
+		StartingColor:          "#ffffff",
+		FinalGradientStops:     gradientStops,
+		FinalGradientSteps:     12,
+		FinalGradientFrames:    5,
+		FinalGradientDirection: "horizontal",
+		Easing:                 "quad",
+	}
+}
+
+func printConfig(width, height int, frameDelay time.Duration, theme, text string) any {
+	// Get theme colors for print effect
+	var gradientStops []string
+
+	switch theme {
+	case "dracula":
+		gradientStops = []string{"#ff79c6", "#bd93f9", "#8be9fd"}
+	case "gruvbox":
+		gradientStops = []string{"#fe8019", "#fabd2f", "#b8bb26"}
+	case "nord":
+		gradientStops = []string{"#88c0d0", "#81a1c1", "#5e81ac"}
+	case "tokyo-night":
+		gradientStops = []string{"#9ece6a", "#e0af68", "#bb9af7"}
+	case "catppuccin":
+		gradientStops = []string{"#cba6f7", "#f5c2e7", "#f5e0dc"}
+	case "material":
+		gradientStops = []string{"#03dac6", "#bb86fc", "#cf6679"}
+	case "solarized":
+		gradientStops = []string{"#268bd2", "#2aa198", "#859900"}
+	case "monochrome":
+		gradientStops = []string{"#808080", "#c0c0c0", "#ffffff"}
+	case "transishardjob":
+		gradientStops = []string{"#55cdfc", "#f7a8b8", "#ffffff"}
+	default:
+		gradientStops = []string{"#8A008A", "#00D1FF", "#FFFFFF"}
+	}
+
+	return &animations.PrintConfig{
+		Width:            width,
+		Height:           height,
+		Text:             text,
+		CharDelay:        30 * time.Millisecond,
+		PrintSpeed:       2,
+		PrintHeadSymbol:  "█",
+		TrailSymbols:     []string{"░", "▒", "▓"},
+		GradientStops:    gradientStops,
+		Human:            false,
+		Jitter:           0.5,
+		PunctuationPause: 250 * time.Millisecond,
+		LinePause:        400 * time.Millisecond,
+		TypoChance:       0.03,
+		CarriageReturn:   0,
+		FrameDuration:    frameDelay,
+	}
+}
+
+func beamsConfig(width, height int, frameDelay time.Duration, theme, text string) any {
+	// Get theme colors for beams effect
+	var beamGradientStops []string
+	var finalGradientStops []string
+
+	switch theme {
+	case "dracula":
+		beamGradientStops = []string{"#ffffff", "#8be9fd", "#bd93f9"}
+		finalGradientStops = []string{"#6272a4", "#bd93f9", "#f8f8f2"}
+	case "gruvbox":
+		beamGradientStops = []string{"#ffffff", "#fabd2f", "#fe8019"}
+		finalGradientStops = []string{"#504945", "#fabd2f", "#ebdbb2"}
+	case "nord":
+		beamGradientStops = []string{"#ffffff", "#88c0d0", "#81a1c1"}
+		finalGradientStops = []string{"#434c5e", "#88c0d0", "#eceff4"}
+	case "tokyo-night":
+		beamGradientStops = []string{"#ffffff", "#7dcfff", "#bb9af7"}
+		finalGradientStops = []string{"#414868", "#7aa2f7", "#c0caf5"}
+	case "catppuccin":
+		beamGradientStops = []string{"#ffffff", "#89dceb", "#cba6f7"}
+		finalGradientStops = []string{"#45475a", "#cba6f7", "#cdd6f4"}
+	case "material":
+		beamGradientStops = []string{"#ffffff", "#89ddff", "#bb86fc"}
+		finalGradientStops = []string{"#546e7a", "#89ddff", "#eceff1"}
+	case "solarized":
+		beamGradientStops = []string{"#ffffff", "#2aa198", "#268bd2"}
+		finalGradientStops = []string{"#586e75", "#2aa198", "#fdf6e3"}
+	case "monochrome":
+		beamGradientStops = []string{"#ffffff", "#c0c0c0", "#808080"}
+		finalGradientStops = []string{"#3a3a3a", "#9a9a9a", "#ffffff"}
+	case "transishardjob":
+		beamGradientStops = []string{"#ffffff", "#55cdfc", "#f7a8b8"}
+		finalGradientStops = []string{"#55cdfc", "#f7a8b8", "#ffffff"}
+	default:
+		beamGradientStops = []string{"#ffffff", "#00D1FF", "#8A008A"}
+		finalGradientStops = []string{"#4A4A4A", "#00D1FF", "#FFFFFF"}
+	}
+
+	return &animations.BeamsConfig{
+		Width:                  width,
+		Height:                 height,
+		Text:                   text,
+		BeamRowSymbols:         []rune{'▂', '▁', '_'},
+		BeamColumnSymbols:      []rune{'▌', '▍', '▎', '▏'},
+		BeamDelay:              0,        // Auto, faster without text
+		BeamRowSpeedRange:      [2]int{}, // Auto
+		BeamColumnSpeedRange:   [2]int{}, // Auto
+		BeamGradientStops:      beamGradientStops,
+		BeamGradientSteps:      0, // Auto
+		BeamGradientFrames:     1,
+		FinalGradientStops:     finalGradientStops,
+		FinalGradientSteps:     8,
+		FinalGradientFrames:    1,
+		FinalWipeSpeed:         3,
+		BeamDirections:         []string{"row", "column"},
+		BeamDiagonalSymbols:    []rune{'▓', '▒', '░'},
+		BeamDiagonalSpeedRange: [2]int{}, // Auto
+		BackgroundDensity:      1,
+		HoldDuration:           0, // Auto, 5s with text
+		Loop:                   "restart",
+		FrameDuration:          frameDelay,
+	}
+}
+
+func decryptConfig(width, height int, frameDelay time.Duration, theme, text string) any {
+	// Get theme colors for decrypt effect
+	var ciphertextColors []string
+	var gradientStops []string
+
+	switch theme {
+	case "dracula":
+		ciphertextColors = []string{"#008000", "#00cb00", "#00ff00"}
+		gradientStops = []string{"#ff79c6"}
+	case "gruvbox":
+		ciphertextColors = []string{"#008000", "#00cb00", "#00ff00"}
+		gradientStops = []string{"#fe8019"}
+	case "nord":
+		ciphertextColors = []string{"#008000", "#00cb00", "#00ff00"}
+		gradientStops = []string{"#88c0d0"}
+	case "tokyo-night":
+		ciphertextColors = []string{"#008000", "#00cb00", "#00ff00"}
+		gradientStops = []string{"#9ece6a"}
+	case "catppuccin":
+		ciphertextColors = []string{"#008000", "#00cb00", "#00ff00"}
+		gradientStops = []string{"#cba6f7"}
+	case "material":
+		ciphertextColors = []string{"#008000", "#00cb00", "#00ff00"}
+		gradientStops = []string{"#03dac6"}
+	case "solarized":
+		ciphertextColors = []string{"#008000", "#00cb00", "#00ff00"}
+		gradientStops = []string{"#268bd2"}
+	case "monochrome":
+		ciphertextColors = []string{"#808080", "#a0a0a0", "#c0c0c0"}
+		gradientStops = []string{"#ffffff"}
+	case "transishardjob":
+		ciphertextColors = []string{"#008000", "#00cb00", "#00ff00"}
+		gradientStops = []string{"#55cdfc"}
+	default:
+		ciphertextColors = []string{"#008000", "#00cb00", "#00ff00"}
+		gradientStops = []string{"#eda000"}
+	}
+
+	return &animations.DecryptConfig{
+		Width:                  width,
+		Height:                 height,
+		Text:                   text,
+		Palette:                []string{}, // Not used in decrypt effect
+		TypingSpeed:            2,          // Slower for better visibility
+		CiphertextColors:       ciphertextColors,
+		FinalGradientStops:     gradientStops,
+		FinalGradientSteps:     12,
+		FinalGradientDirection: "vertical",
+		SymbolSet:              "default",
+		RevealOrder:            "random",
+		BlockSymbols:           []rune{'▉', '▓', '▒', '░'},
+		BlockDuration:          600 * time.Millisecond,
+		ScrambleDuration:       3 * time.Second,
+		ScrambleInterval:       100 * time.Millisecond,
+		SlowDuration:           2 * time.Second,
+		SlowInterval:           250 * time.Millisecond,
+		DiscoveryColor:         "#ffffff",
+		DiscoverySteps:         15,
+		DiscoveryDuration:      750 * time.Millisecond,
+		HoldDuration:           2500 * time.Millisecond,
+		FrameDuration:          frameDelay,
+	}
+}
+
+func aquariumConfig(width, height int, _ time.Duration, theme, _ string) any {
+	// Theme-specific colors for aquarium
+	var fishColors []string
+	var waterColors []string
+	var seaweedColors []string
+	var bubbleColor string
+	var diverColor string
+	var boatColor string
+	var mermaidColor string
+	var anchorColor string
+
+	switch theme {
+	case "dracula":
+		fishColors = []string{"#ff79c6", "#bd93f9", "#8be9fd", "#50fa7b", "#ffb86c"}
+		waterColors = []string{"#6272a4", "#c2b280"}
+		seaweedColors = []string{"#44475a", "#50fa7b", "#8be9fd"}
+		bubbleColor = "#8be9fd"
+		diverColor = "#f8f8f2"
+		boatColor = "#ffb86c"
+		mermaidColor = "#ff79c6"
+		anchorColor = "#6272a4"
+	case "gruvbox":
+		fishColors = []string{"#fe8019", "#fabd2f", "#b8bb26", "#83a598", "#d3869b"}
+		waterColors = []string{"#458588", "#d79921"}
+		seaweedColors = []string{"#3c3836", "#98971a", "#b8bb26"}
+		bubbleColor = "#83a598"
+		diverColor = "#ebdbb2"
+		boatColor = "#fabd2f"
+		mermaidColor = "#d3869b"
+		anchorColor = "#504945"
+	case "nord":
+		fishColors = []string{"#88c0d0", "#81a1c1", "#5e81ac", "#8fbcbb", "#b48ead"}
+		waterColors = []string{"#5e81ac", "#d08770"}
+		seaweedColors = []string{"#2e3440", "#a3be8c", "#8fbcbb"}
+		bubbleColor = "#88c0d0"
+		diverColor = "#eceff4"
+		boatColor = "#d08770"
+		mermaidColor = "#b48ead"
+		anchorColor = "#4c566a"
+	case "tokyo-night":
+		fishColors = []string{"#7aa2f7", "#bb9af7", "#7dcfff", "#9ece6a", "#f7768e"}
+		waterColors = []string{"#7aa2f7", "#e0af68"}
+		seaweedColors = []string{"#1a1b26", "#9ece6a", "#7dcfff"}
+		bubbleColor = "#7dcfff"
+		diverColor = "#c0caf5"
+		boatColor = "#e0af68"
+		mermaidColor = "#bb9af7"
+		anchorColor = "#414868"
+	case "catppuccin":
+		fishColors = []string{"#f5c2e7", "#cba6f7", "#89dceb", "#a6e3a1", "#fab387"}
+		waterColors = []string{"#89b4fa", "#f9e2af"}
+		seaweedColors = []string{"#1e1e2e", "#a6e3a1", "#94e2d5"}
+		bubbleColor = "#89dceb"
+		diverColor = "#cdd6f4"
+		boatColor = "#fab387"
+		mermaidColor = "#f5c2e7"
+		anchorColor = "#45475a"
+	case "material":
+		fishColors = []string{"#82aaff", "#c792ea", "#89ddff", "#c3e88d", "#f78c6c"}
+		waterColors = []string{"#82aaff", "#ffcb6b"}
+		seaweedColors = []string{"#263238", "#c3e88d", "#89ddff"}
+		bubbleColor = "#89ddff"
+		diverColor = "#eceff1"
+		boatColor = "#ffcb6b"
+		mermaidColor = "#c792ea"
+		anchorColor = "#37474f"
+	case "solarized":
+		fishColors = []string{"#268bd2", "#2aa198", "#859900", "#cb4b16", "#6c71c4"}
+		waterColors = []string{"#268bd2", "#b58900"}
+		seaweedColors = []string{"#002b36", "#859900", "#2aa198"}
+		bubbleColor = "#2aa198"
+		diverColor = "#fdf6e3"
+		boatColor = "#cb4b16"
+		mermaidColor = "#d33682"
+		anchorColor = "#073642"
+	case "monochrome":
+		fishColors = []string{"#9a9a9a", "#bababa", "#dadada", "#c0c0c0", "#808080"}
+		waterColors = []string{"#5a5a5a", "#8a8a8a"}
+		seaweedColors = []string{"#1a1a1a", "#5a5a5a", "#7a7a7a"}
+		bubbleColor = "#c0c0c0"
+		diverColor = "#ffffff"
+		boatColor = "#9a9a9a"
+		mermaidColor = "#bababa"
+		anchorColor = "#3a3a3a"
+	case "transishardjob":
+		fishColors = []string{"#55cdfc", "#f7a8b8", "#ffffff", "#f7a8b8", "#55cdfc"}
+		waterColors = []string{"#55cdfc", "#f7a8b8"}
+		seaweedColors = []string{"#1a1a1a", "#55cdfc", "#f7a8b8"}
+		bubbleColor = "#ffffff"
+		diverColor = "#ffffff"
+		boatColor = "#f7a8b8"
+		mermaidColor = "#f7a8b8"
+		anchorColor = "#55cdfc"
+	default:
+		fishColors = []string{"#00ffff", "#ff00ff", "#ffff00", "#00ff00", "#ff8000"}
+		waterColors = []string{"#4a9eff", "#c2b280"}
+		seaweedColors = []string{"#001a1a", "#00ff00", "#00ffff"}
+		bubbleColor = "#00ffff"
+		diverColor = "#ffffff"
+		boatColor = "#ff8000"
+		mermaidColor = "#ff00ff"
+		anchorColor = "#808080"
+	}
+
+	return &animations.AquariumConfig{
+		Width:         width,
+		Height:        height,
+		FishColors:    fishColors,
+		WaterColors:   waterColors,
+		SeaweedColors: seaweedColors,
+		BubbleColor:   bubbleColor,
+		DiverColor:    diverColor,
+		BoatColor:     boatColor,
+		MermaidColor:  mermaidColor,
+		AnchorColor:   anchorColor,
+	}
+}
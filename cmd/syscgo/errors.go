@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// Exit statuses. Scripts can rely on these staying stable.
+const (
+	exitOK            = 0
+	exitFailure       = 1   // Any other error
+	exitUsage         = 2   // Invalid flags, arguments or parameter values
+	exitUnknownEffect = 3   // -effect or describe named an effect that doesn't exist
+	exitUnknownTheme  = 4   // -theme named an unknown theme (with -strict)
+	exitIO            = 5   // A file or stdin couldn't be read
+	exitNotTTY        = 6   // stdout is not a terminal (with -strict)
+	exitInterrupted   = 130 // Stopped by SIGINT or SIGTERM
+)
+
+// errorKinds are the machine-readable labels printed with each exit status
+var errorKinds = map[int]string{
+	exitFailure:       "error",
+	exitUsage:         "usage",
+	exitUnknownEffect: "unknown-effect",
+	exitUnknownTheme:  "unknown-theme",
+	exitIO:            "io",
+	exitNotTTY:        "not-tty",
+}
+
+// fail prints an error to stderr as "syscgo: <kind>: <message>" and exits
+// with the matching status
+func fail(code int, format string, args ...any) {
+	fmt.Fprintf(os.Stderr, "syscgo: %s: %s\n", errorKinds[code], fmt.Sprintf(format, args...))
+	os.Exit(code)
+}
+
+// warn prints a non-fatal problem to stderr
+func warn(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, "syscgo: warning: %s\n", fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// exportFormats are the file formats export can write
+var exportFormats = []string{"ansi", "asciicast"}
+
+func exportFlags(fs *flag.FlagSet) func(args []string) {
+	var o effectOptions
+	o.register(fs)
+	output := fs.String("o", "-", "Write to `path` instead of stdout")
+	format := fs.String("format", "", "Output format ("+strings.Join(exportFormats, ", ")+"), asciicast for .cast files and ansi otherwise")
+	duration := fs.Int("duration", 10, "Seconds of animation to export")
+
+	return func(args []string) {
+		if len(args) != 1 {
+			fail(exitUsage, "usage: syscgo export <effect> [flags]")
+		}
+		e := mustFindEffect(args[0])
+		t := o.resolveTheme()
+
+		if *format == "" {
+			*format = "ansi"
+			if strings.HasSuffix(*output, ".cast") {
+				*format = "asciicast"
+			}
+		}
+		if *format != "ansi" && *format != "asciicast" {
+			fail(exitUsage, "-format must be one of %s, got %q", strings.Join(exportFormats, ", "), *format)
+		}
+		if *duration <= 0 {
+			fail(exitUsage, "-duration must be at least 1 second")
+		}
+
+		w, h, _ := o.size()
+
+		anim := o.build(e, t, w, h, o.readText(e))
+
+		out := os.Stdout
+		if *output != "-" {
+			f, err := os.Create(*output)
+			if err != nil {
+				fail(exitIO, "%v", err)
+			}
+			defer f.Close()
+			out = f
+		}
+
+		buf := bufio.NewWriter(out)
+		var err error
+		if *format == "asciicast" {
+			err = exportAsciicast(buf, anim, e.frameDelay, *duration*20, w, h)
+		} else {
+			err = exportANSI(buf, anim, *duration*20)
+		}
+		if err == nil {
+			err = buf.Flush()
+		}
+		if err != nil {
+			fail(exitIO, "writing %s: %v", *output, err)
+		}
+	}
+}
+
+// exportFrames renders up to frames frames of an effect, stopping early once a
+// text effect completes
+func exportFrames(anim animator, frames int, emit func(frame int, output string) error) error {
+	for frame := 0; frame < frames; frame++ {
+		anim.Update()
+		if err := emit(frame, anim.Render()); err != nil {
+			return err
+		}
+		if c, ok := anim.(completer); ok && c.IsComplete() {
+			break
+		}
+	}
+	return nil
+}
+
+// exportANSI writes frames as they would be printed to a terminal
+func exportANSI(w io.Writer, anim animator, frames int) error {
+	if _, err := io.WriteString(w, "\033[2J"); err != nil {
+		return err
+	}
+	return exportFrames(anim, frames, func(_ int, output string) error {
+		_, err := io.WriteString(w, "\033[H"+output)
+		return err
+	})
+}
+
+// exportAsciicast writes an asciicast v2 recording playable with asciinema
+func exportAsciicast(w io.Writer, anim animator, delay time.Duration, frames, width, height int) error {
+	header, err := json.Marshal(map[string]any{
+		"version":   2,
+		"width":     width,
+		"height":    height,
+		"timestamp": time.Now().Unix(),
+		"env":       map[string]string{"TERM": "xterm-256color"},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "%s\n", header); err != nil {
+		return err
+	}
+
+	return exportFrames(anim, frames, func(frame int, output string) error {
+		data := "\033[H" + strings.ReplaceAll(output, "\n", "\r\n")
+		if frame == 0 {
+			data = "\033[2J" + data
+		}
+		event, err := json.Marshal([]any{(time.Duration(frame) * delay).Seconds(), "o", data})
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", event)
+		return err
+	})
+}
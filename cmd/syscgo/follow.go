@@ -0,0 +1,83 @@
+package main
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// appender is implemented by effects that can take more text after creation
+type appender interface {
+	AppendText(text string)
+}
+
+// followTailLines is how many existing lines of a followed file are shown, like tail
+const followTailLines = 10
+
+// followStdin streams lines from stdin until it is closed
+func followStdin() <-chan string {
+	lines := make(chan string, 64)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+	}()
+	return lines
+}
+
+// followFile returns the last few lines of a file and streams lines appended
+// to it afterwards, starting over if the file is truncated
+func followFile(path string) (string, <-chan string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", nil, err
+	}
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		f.Close()
+		return "", nil, err
+	}
+	offset := int64(len(data))
+
+	existing := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	if len(existing) > followTailLines {
+		existing = existing[len(existing)-followTailLines:]
+	}
+
+	lines := make(chan string, 64)
+	go func() {
+		defer f.Close()
+		var partial string
+		buf := make([]byte, 32*1024)
+		for {
+			if info, err := f.Stat(); err == nil && info.Size() < offset {
+				// File was truncated or rotated in place, read from the start
+				offset = 0
+				partial = ""
+			}
+
+			n, err := f.ReadAt(buf, offset)
+			if n > 0 {
+				offset += int64(n)
+				chunk := partial + string(buf[:n])
+				parts := strings.Split(chunk, "\n")
+				partial = parts[len(parts)-1]
+				for _, line := range parts[:len(parts)-1] {
+					lines <- strings.TrimSuffix(line, "\r")
+				}
+			}
+			if err == io.EOF || n == 0 {
+				time.Sleep(250 * time.Millisecond)
+			} else if err != nil {
+				return
+			}
+		}
+	}()
+
+	return strings.Join(existing, "\n"), lines, nil
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// listOutput is the document printed by list -json
+type listOutput struct {
+	Effects []effectInfo `json:"effects"`
+	Themes  []themeInfo  `json:"themes"`
+}
+
+type effectInfo struct {
+	Name       string      `json:"name"`
+	Summary    string      `json:"summary"`
+	TakesText  bool        `json:"takes_text"`
+	Parameters []paramInfo `json:"parameters"`
+}
+
+type paramInfo struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Default string `json:"default"`
+	Valid   string `json:"valid"`
+}
+
+type themeInfo struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// listEffects prints all effects and themes, as JSON when asJSON is set
+func listEffects(asJSON bool, width, height int, theme themeDef) {
+	if !asJSON {
+		fmt.Println("Effects:")
+		for _, e := range effects {
+			fmt.Printf("  %-10s %s\n", e.name, e.summary)
+		}
+		fmt.Println()
+		fmt.Println("Themes:")
+		listThemes()
+		return
+	}
+
+	out := listOutput{
+		Effects: []effectInfo{},
+		Themes:  []themeInfo{},
+	}
+	for _, e := range effects {
+		info := effectInfo{
+			Name:       e.name,
+			Summary:    e.summary,
+			TakesText:  e.takesText,
+			Parameters: []paramInfo{},
+		}
+		config, err := effectConfig(e, width, height, theme, e.defaultText)
+		if err != nil {
+			fail(exitUsage, "%v", err)
+		}
+		for _, p := range effectParams(e.name, config) {
+			info.Parameters = append(info.Parameters, paramInfo{
+				Name:    p.name,
+				Type:    p.typeName(),
+				Default: p.defaultString(),
+				Valid:   p.rangeString(),
+			})
+		}
+		out.Effects = append(out.Effects, info)
+	}
+	for _, t := range allThemes() {
+		out.Themes = append(out.Themes, themeInfo{Name: t.name, Description: t.description})
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	enc.Encode(out)
+}
+
+// listThemes prints the built-in and user themes
+func listThemes() {
+	for _, t := range allThemes() {
+		if t.path != "" {
+			fmt.Printf("  %-15s %s (%s)\n", t.name, t.description, t.path)
+			continue
+		}
+		fmt.Printf("  %-15s %s\n", t.name, t.description)
+	}
+}
@@ -0,0 +1,519 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+	"unicode"
+
+	"github.com/Nomadcxx/sysc-Go/animations"
+)
+
+// paramRule constrains the values accepted for a single config field
+type paramRule struct {
+	min, max float64  // Inclusive numeric range (ignored when both are zero)
+	choices  []string // Allowed string values
+	hidden   bool     // Field is not exposed on the command line
+	auto     bool     // Zero lets the effect pick a default, shown and set as "auto"
+}
+
+// paramRules holds the per-effect constraints keyed by config field name.
+// Fields without an entry accept any value of their type.
+var paramRules = map[string]map[string]paramRule{
+	"fire": {
+		"Source":       {choices: []string{"bottom", "points", "mask"}},
+		"SourceMask":   {hidden: true}, // Set from the text
+		"Wind":         {min: -4, max: 4},
+		"MaxHeight":    {min: 1, max: 1000, auto: true},
+		"DecayProfile": {choices: []string{"classic", "linear", "exponential"}},
+		"DecayFunc":    {hidden: true}, // Library only
+	},
+	"matrix": {
+		"GlyphSet":    {choices: []string{"classic", "katakana", "binary", "hex"}},
+		"Density":     {min: 0.001, max: 1},
+		"SpeedRange":  {min: 1, max: 20},
+		"LengthRange": {min: 1, max: 200},
+		"Flicker":     {min: 0.0001, max: 1},
+		"HeadGlyph":   {hidden: true}, // Library only
+		"Message":     {hidden: true}, // Set from the text
+	},
+	"rain": {
+		"Wind":            {min: -3, max: 3},
+		"IntensityPeriod": {min: 20, max: 100000},
+		"LightningChance": {min: 0.0001, max: 1},
+	},
+	"snow": {
+		"Layers":   {min: 1, max: 6},
+		"Density":  {min: 0.001, max: 0.5},
+		"MeltRate": {min: 0.00001, max: 1},
+		"MaxDrift": {min: 1, max: 1000},
+	},
+	"fireworks": {
+		"Mode":         {choices: []string{"bezier", "physics"}},
+		"Gravity":      {min: 0.001, max: 1},
+		"Drag":         {min: 0.001, max: 0.5},
+		"ShellWeights": {choices: animations.FireworksShellTypes, min: 0, max: 100},
+		"TextHold":     {min: 1, max: 1000},
+		"MaxShells":    {min: 1, max: 1000},
+		"MaxParticles": {min: 1, max: 100000, auto: true},
+	},
+	"decrypt": {
+		"Palette":                {hidden: true}, // Not used in decrypt effect
+		"TypingSpeed":            {min: 1, max: 100},
+		"FinalGradientSteps":     {min: 1, max: 100},
+		"FinalGradientDirection": {choices: []string{"horizontal", "vertical"}},
+		"SymbolSet":              {choices: []string{"default", "ascii", "hex", "binary", "katakana", "blocks"}},
+		"RevealOrder":            {choices: animations.DecryptRevealOrders},
+		"BlockDuration":          {min: float64(time.Millisecond), max: float64(time.Minute)},
+		"ScrambleDuration":       {min: float64(time.Millisecond), max: float64(time.Minute)},
+		"ScrambleInterval":       {min: float64(time.Millisecond), max: float64(10 * time.Second)},
+		"SlowDuration":           {min: float64(time.Millisecond), max: float64(time.Minute)},
+		"SlowInterval":           {min: float64(time.Millisecond), max: float64(10 * time.Second)},
+		"DiscoverySteps":         {min: 1, max: 100},
+		"DiscoveryDuration":      {min: float64(time.Millisecond), max: float64(time.Minute)},
+		"HoldDuration":           {min: float64(time.Millisecond), max: float64(time.Minute)},
+		"FrameDuration":          {hidden: true}, // Follows the frame delay
+		"OnPhaseChange":          {hidden: true}, // Library only
+		"OnComplete":             {hidden: true}, // Library only
+	},
+	"pour": {
+		"PourDirection":          {choices: animations.PourDirections},
+		"Easing":                 {choices: animations.PourEasings},
+		"PourSpeed":              {min: 1, max: 100},
+		"MovementSpeed":          {min: 0.01, max: 1},
+		"Gap":                    {min: 0, max: 100},
+		"FinalGradientSteps":     {min: 1, max: 100},
+		"FinalGradientFrames":    {min: 1, max: 100},
+		"FinalGradientDirection": {choices: []string{"horizontal", "vertical"}},
+		"OnPhaseChange":          {hidden: true}, // Library only
+		"OnComplete":             {hidden: true}, // Library only
+	},
+	"print": {
+		"CharDelay":        {min: 0, max: float64(5 * time.Second)},
+		"PrintSpeed":       {min: 1, max: 100},
+		"Jitter":           {min: 0, max: 1},
+		"PunctuationPause": {min: 0, max: float64(5 * time.Second)},
+		"LinePause":        {min: 0, max: float64(5 * time.Second)},
+		"TypoChance":       {min: 0, max: 1},
+		"CarriageReturn":   {min: 0, max: float64(5 * time.Second)},
+		"FrameDuration":    {hidden: true}, // Follows the frame delay
+		"OnKeystroke":      {hidden: true}, // Library only
+		"OnPhaseChange":    {hidden: true}, // Library only
+		"OnComplete":       {hidden: true}, // Library only
+	},
+	"beams": {
+		"BeamDelay":              {min: 1, max: 100, auto: true},
+		"BeamRowSpeedRange":      {min: 1, max: 500, auto: true},
+		"BeamColumnSpeedRange":   {min: 1, max: 500, auto: true},
+		"BeamGradientSteps":      {min: 1, max: 100, auto: true},
+		"BeamGradientFrames":     {min: 1, max: 100},
+		"FinalGradientSteps":     {min: 1, max: 100},
+		"FinalGradientFrames":    {min: 1, max: 100},
+		"FinalWipeSpeed":         {min: 1, max: 100},
+		"BeamDirections":         {choices: animations.BeamsDirections},
+		"BeamDiagonalSpeedRange": {min: 1, max: 500, auto: true},
+		"BackgroundDensity":      {min: 0.01, max: 1},
+		"HoldDuration":           {min: float64(time.Millisecond), max: float64(time.Minute), auto: true},
+		"Loop":                   {choices: animations.BeamsLoopModes},
+		"FrameDuration":          {hidden: true}, // Follows the frame delay
+		"OnPhaseChange":          {hidden: true}, // Library only
+		"OnComplete":             {hidden: true}, // Library only
+	},
+}
+
+// fixedFields are set by the CLI itself and can't be overridden with -set
+var fixedFields = map[string]bool{
+	"Width":  true,
+	"Height": true,
+	"Text":   true,
+}
+
+var hexColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// setFlags collects repeated -set key=value arguments
+type setFlags []string
+
+func (s *setFlags) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *setFlags) Set(value string) error {
+	if !strings.Contains(value, "=") {
+		return fmt.Errorf("expected key=value, got %q", value)
+	}
+	*s = append(*s, value)
+	return nil
+}
+
+// param is a single settable field of an effect config
+type param struct {
+	name  string // Command line name, e.g. pour-direction
+	field reflect.StructField
+	value reflect.Value
+	rule  paramRule
+}
+
+// paramName converts a Go field name to its command line form (PourDirection -> pour-direction)
+func paramName(field string) string {
+	var b strings.Builder
+	runes := []rune(field)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// Start a new word unless this continues an acronym
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteRune('-')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// normalizeKey strips separators and case so pour-direction, pour_direction
+// and PourDirection all match the same field
+func normalizeKey(key string) string {
+	key = strings.ToLower(key)
+	key = strings.ReplaceAll(key, "-", "")
+	return strings.ReplaceAll(key, "_", "")
+}
+
+// effectParams lists the settable fields of an effect config struct pointer
+func effectParams(effect string, config any) []param {
+	if config == nil {
+		return nil
+	}
+
+	v := reflect.ValueOf(config).Elem()
+	t := v.Type()
+	rules := paramRules[effect]
+
+	var params []param
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		rule := rules[field.Name]
+		if !field.IsExported() || fixedFields[field.Name] || rule.hidden {
+			continue
+		}
+		params = append(params, param{
+			name:  paramName(field.Name),
+			field: field,
+			value: v.Field(i),
+			rule:  rule,
+		})
+	}
+	return params
+}
+
+// applyOverrides parses key=value pairs into the effect config
+func applyOverrides(effect string, config any, overrides []string) error {
+	if len(overrides) == 0 {
+		return nil
+	}
+	if config == nil {
+		return fmt.Errorf("effect %s has no settable parameters", effect)
+	}
+
+	params := effectParams(effect, config)
+	for _, override := range overrides {
+		key, value, _ := strings.Cut(override, "=")
+
+		var target *param
+		for i := range params {
+			if normalizeKey(params[i].name) == normalizeKey(key) {
+				target = &params[i]
+				break
+			}
+		}
+		if target == nil {
+			return fmt.Errorf("unknown parameter %q for effect %s (see: syscgo describe %s)", key, effect, effect)
+		}
+
+		if err := target.set(value); err != nil {
+			return fmt.Errorf("%s: %w", target.name, err)
+		}
+	}
+	return nil
+}
+
+// set parses value according to the field type and validates it
+func (p *param) set(value string) error {
+	if p.rule.auto && value == "auto" {
+		p.value.SetZero()
+		return nil
+	}
+
+	switch p.value.Interface().(type) {
+	case string:
+		if err := p.checkString(value); err != nil {
+			return err
+		}
+		p.value.SetString(value)
+
+	case int:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("expected integer, got %q", value)
+		}
+		if err := p.checkRange(float64(n)); err != nil {
+			return err
+		}
+		p.value.SetInt(int64(n))
+
+	case float64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("expected number, got %q", value)
+		}
+		if err := p.checkRange(f); err != nil {
+			return err
+		}
+		p.value.SetFloat(f)
+
+	case bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("expected true or false, got %q", value)
+		}
+		p.value.SetBool(b)
+
+	case time.Duration:
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("expected duration such as 30ms, got %q", value)
+		}
+		if err := p.checkRange(float64(d)); err != nil {
+			return err
+		}
+		p.value.SetInt(int64(d))
+
+	case []string:
+		items := strings.Split(value, ",")
+		for i := range items {
+			items[i] = strings.TrimSpace(items[i])
+			if err := p.checkString(items[i]); err != nil {
+				return err
+			}
+		}
+		p.value.Set(reflect.ValueOf(items))
+
+	case []rune:
+		runes := []rune(value)
+		if len(runes) == 0 {
+			return fmt.Errorf("expected at least one symbol")
+		}
+		p.value.Set(reflect.ValueOf(runes))
+
+	case [][2]int:
+		var points [][2]int
+		for _, pair := range strings.Split(value, ";") {
+			xs, ys, ok := strings.Cut(pair, ",")
+			x, errX := strconv.Atoi(strings.TrimSpace(xs))
+			y, errY := strconv.Atoi(strings.TrimSpace(ys))
+			if !ok || errX != nil || errY != nil {
+				return fmt.Errorf("expected x,y;x,y... integers, got %q", value)
+			}
+			points = append(points, [2]int{x, y})
+		}
+		p.value.Set(reflect.ValueOf(points))
+
+	case [2]int:
+		lo, hi, ok := strings.Cut(value, ",")
+		if !ok {
+			return fmt.Errorf("expected min,max, got %q", value)
+		}
+		a, errA := strconv.Atoi(strings.TrimSpace(lo))
+		b, errB := strconv.Atoi(strings.TrimSpace(hi))
+		if errA != nil || errB != nil {
+			return fmt.Errorf("expected min,max integers, got %q", value)
+		}
+		if a >= b {
+			return fmt.Errorf("min %d must be less than max %d", a, b)
+		}
+		if err := p.checkRange(float64(a)); err != nil {
+			return err
+		}
+		if err := p.checkRange(float64(b)); err != nil {
+			return err
+		}
+		p.value.Set(reflect.ValueOf([2]int{a, b}))
+
+	case map[string]float64:
+		weights := make(map[string]float64)
+		for _, pair := range strings.Split(value, ",") {
+			key, num, ok := strings.Cut(pair, "=")
+			key = strings.TrimSpace(key)
+			f, err := strconv.ParseFloat(strings.TrimSpace(num), 64)
+			if !ok || err != nil {
+				return fmt.Errorf("expected name=number,..., got %q", value)
+			}
+			if err := p.checkString(key); err != nil {
+				return err
+			}
+			if err := p.checkRange(f); err != nil {
+				return err
+			}
+			weights[key] = f
+		}
+		p.value.Set(reflect.ValueOf(weights))
+
+	default:
+		return fmt.Errorf("unsupported parameter type %s", p.field.Type)
+	}
+	return nil
+}
+
+// isColor reports whether the field holds hex colors
+func (p *param) isColor() bool {
+	name := p.field.Name
+	return name == "Palette" || strings.HasSuffix(name, "Color") || strings.HasSuffix(name, "Colors") || strings.HasSuffix(name, "Stops")
+}
+
+func (p *param) checkString(value string) error {
+	if len(p.rule.choices) > 0 {
+		for _, choice := range p.rule.choices {
+			if value == choice {
+				return nil
+			}
+		}
+		return fmt.Errorf("must be one of %s, got %q", strings.Join(p.rule.choices, ", "), value)
+	}
+	if p.isColor() && !hexColorPattern.MatchString(value) {
+		return fmt.Errorf("expected hex color such as #ff79c6, got %q", value)
+	}
+	return nil
+}
+
+func (p *param) checkRange(value float64) error {
+	if p.rule.min == 0 && p.rule.max == 0 {
+		return nil
+	}
+	if value < p.rule.min || value > p.rule.max {
+		return fmt.Errorf("must be within %s, got %s", p.rangeString(), p.format(value))
+	}
+	return nil
+}
+
+// format renders a range bound in the field's own units
+func (p *param) format(value float64) string {
+	if p.field.Type == reflect.TypeOf(time.Duration(0)) {
+		return time.Duration(value).String()
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// typeName returns a short human readable type for describe
+func (p *param) typeName() string {
+	switch p.value.Interface().(type) {
+	case []string:
+		if p.isColor() {
+			return "colors"
+		}
+		return "list"
+	case []rune:
+		return "symbols"
+	case [2]int:
+		return "int range"
+	case [][2]int:
+		return "points"
+	case map[string]float64:
+		return "weights"
+	case time.Duration:
+		return "duration"
+	case string:
+		if p.isColor() {
+			return "color"
+		}
+	}
+	return p.field.Type.String()
+}
+
+// defaultString formats the current field value the way -set accepts it
+func (p *param) defaultString() string {
+	if p.rule.auto && p.value.IsZero() {
+		return "auto"
+	}
+	switch v := p.value.Interface().(type) {
+	case []string:
+		return strings.Join(v, ",")
+	case []rune:
+		return string(v)
+	case [2]int:
+		return fmt.Sprintf("%d,%d", v[0], v[1])
+	case [][2]int:
+		pairs := make([]string, len(v))
+		for i, point := range v {
+			pairs[i] = fmt.Sprintf("%d,%d", point[0], point[1])
+		}
+		return strings.Join(pairs, ";")
+	case map[string]float64:
+		// In the order of the choices, which is how the effect lists them
+		var pairs []string
+		for _, key := range p.rule.choices {
+			if w, ok := v[key]; ok {
+				pairs = append(pairs, key+"="+strconv.FormatFloat(w, 'f', -1, 64))
+			}
+		}
+		return strings.Join(pairs, ",")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// rangeString describes the valid values of the parameter
+func (p *param) rangeString() string {
+	if p.rule.auto {
+		fixed := *p
+		fixed.rule.auto = false
+		return fixed.rangeString() + " or auto"
+	}
+	switch {
+	case p.field.Type == reflect.TypeOf(map[string]float64{}):
+		return "name=" + p.format(p.rule.min) + ".." + p.format(p.rule.max) + ",..."
+	case len(p.rule.choices) > 0:
+		return strings.Join(p.rule.choices, "|")
+	case p.rule.min != 0 || p.rule.max != 0:
+		return p.format(p.rule.min) + ".." + p.format(p.rule.max)
+	case p.isColor():
+		return "#rrggbb"
+	case p.field.Type == reflect.TypeOf([][2]int{}):
+		return "x,y;x,y..."
+	}
+	return "any"
+}
+
+// describeEffect prints every settable parameter of an effect
+func describeEffect(e effectDef, width, height int, theme themeDef) {
+	fmt.Printf("%s - %s\n\n", e.name, e.summary)
+
+	if e.config == nil {
+		fmt.Println("This effect has no settable parameters.")
+		return
+	}
+
+	config, err := effectConfig(e, width, height, theme, e.defaultText)
+	if err != nil {
+		fail(exitUsage, "%v", err)
+	}
+	params := effectParams(e.name, config)
+	if len(params) == 0 {
+		fmt.Println("This effect has no settable parameters.")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  PARAMETER\tTYPE\tDEFAULT\tVALID")
+	for _, p := range params {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", p.name, p.typeName(), p.defaultString(), p.rangeString())
+	}
+	w.Flush()
+
+	fmt.Println()
+	fmt.Printf("Defaults shown for theme %s. Override with -set name=value, e.g.\n", theme.name)
+	fmt.Printf("  syscgo run %s -set %s=%s\n", e.name, params[0].name, params[0].defaultString())
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+)
+
+func previewFlags(fs *flag.FlagSet) func(args []string) {
+	var o effectOptions
+	o.register(fs)
+	duration := fs.Int("duration", 4, "Seconds to play each effect")
+
+	return func(args []string) {
+		names := args
+		if len(names) == 0 {
+			names = effectNames()
+		}
+		// Look every effect up first so a typo fails before anything plays
+		var selected []effectDef
+		for _, name := range names {
+			selected = append(selected, mustFindEffect(name))
+		}
+		if *duration <= 0 {
+			fail(exitUsage, "-duration must be at least 1 second")
+		}
+		if len(selected) > 1 && (o.text != "" || o.file != "") {
+			fail(exitUsage, "-text and -file need a single effect to preview")
+		}
+
+		t := o.resolveTheme()
+		width, height := o.screenSize()
+
+		// A single effect takes text like run does, several show their demo text
+		var texts []string
+		for _, e := range selected {
+			if len(selected) == 1 {
+				texts = append(texts, o.readText(e))
+			} else {
+				texts = append(texts, e.defaultText)
+			}
+		}
+
+		restore := startScreen()
+		defer restore()
+
+		for i, e := range selected {
+			anim := o.build(e, t, width, height, texts[i])
+			fmt.Print("\033[2J")
+			play(anim, playOptions{
+				frames:   *duration * 20,
+				delay:    e.frameDelay,
+				label:    fmt.Sprintf("%s (%d/%d) - %s", e.name, i+1, len(selected), e.summary),
+				labelRow: height,
+			})
+		}
+	}
+}
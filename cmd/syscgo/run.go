@@ -0,0 +1,227 @@
+package main
+
+import (
+	"flag"
+	"strings"
+	"time"
+)
+
+// effectOptions are the flags shared by the commands that render an effect
+type effectOptions struct {
+	theme     string
+	file      string
+	text      string
+	strict    bool
+	width     int
+	height    int
+	overrides setFlags
+}
+
+// register adds the shared flags to a command's flag set
+func (o *effectOptions) register(fs *flag.FlagSet) {
+	fs.StringVar(&o.theme, "theme", "dracula", "Color theme ("+strings.Join(themeNames(), ", ")+")")
+	fs.StringVar(&o.file, "file", "", "Read the text of a text effect from `path`, - for stdin")
+	fs.StringVar(&o.text, "text", "", "Text for a text effect")
+	fs.BoolVar(&o.strict, "strict", false, "Treat unknown themes, missing text and a non-terminal stdout as errors")
+	fs.IntVar(&o.width, "width", 0, "Width in columns (0 = terminal width, or 80)")
+	fs.IntVar(&o.height, "height", 0, "Height in rows (0 = terminal height, or 24)")
+	fs.Var(&o.overrides, "set", "Override an effect parameter as `key=value` (repeatable, see syscgo describe)")
+}
+
+// resolveTheme looks up -theme, warning about unknown themes or failing on
+// them with -strict
+func (o *effectOptions) resolveTheme() themeDef {
+	t, ok := findTheme(o.theme)
+	if !ok {
+		if o.strict {
+			fail(exitUnknownTheme, "%q (available: %s)", o.theme, strings.Join(themeNames(), ", "))
+		}
+		warn("unknown theme %q, using default colors", o.theme)
+		t = themeDef{name: o.theme}
+	}
+	return t
+}
+
+// size returns -width and -height, taking the terminal size for those that
+// weren't given. isTTY reports whether the terminal size was needed and known.
+func (o *effectOptions) size() (width, height int, isTTY bool) {
+	if o.width < 0 || o.height < 0 {
+		fail(exitUsage, "-width and -height can't be negative")
+	}
+	width, height, isTTY = terminalSize()
+	if o.width > 0 {
+		width = o.width
+	}
+	if o.height > 0 {
+		height = o.height
+	}
+	return width, height, isTTY || o.width > 0 && o.height > 0
+}
+
+// screenSize returns the size to render at, warning about a stdout that isn't
+// a terminal or failing on it with -strict, unless -width and -height are set
+func (o *effectOptions) screenSize() (int, int) {
+	width, height, isTTY := o.size()
+	if !isTTY {
+		if o.strict {
+			fail(exitNotTTY, "stdout is not a terminal")
+		}
+		warn("stdout is not a terminal, rendering at %dx%d", width, height)
+	}
+	return width, height
+}
+
+// checkText rejects -text and -file where they don't apply
+func (o *effectOptions) checkText(e effectDef) {
+	if o.text != "" && o.file != "" {
+		fail(exitUsage, "-text and -file are mutually exclusive")
+	}
+	if !e.takesText && (o.file != "" || o.text != "") {
+		fail(exitUsage, "effect %s does not take text", e.name)
+	}
+}
+
+// readText returns the text for e from -text, -file or piped stdin, or the
+// effect's demo text
+func (o *effectOptions) readText(e effectDef) string {
+	o.checkText(e)
+	if !e.takesText {
+		return ""
+	}
+	// Effects that work without text only read stdin when asked to with -file -
+	if e.defaultText == "" && o.file == "" && o.text == "" {
+		return ""
+	}
+
+	text, err := readText(o.file, o.text)
+	if err != nil {
+		fail(exitIO, "%v", err)
+	}
+	if strings.TrimSpace(text) != "" {
+		return text
+	}
+	if o.file != "" || o.text != "" {
+		fail(exitUsage, "no text to display")
+	}
+	if o.strict && e.defaultText != "" {
+		fail(exitUsage, "effect %s needs text from -text, -file or stdin", e.name)
+	}
+	return e.defaultText
+}
+
+// build creates the effect with the theme's colors and the -set overrides
+func (o *effectOptions) build(e effectDef, t themeDef, width, height int, text string) animator {
+	// Wrap text to fit terminal width (leave margin for centering)
+	if text != "" {
+		text = wrapText(text, width-10)
+	}
+
+	config, err := effectConfig(e, width, height, t, text)
+	if err != nil {
+		fail(exitUsage, "%v", err)
+	}
+	if err := applyOverrides(e.name, config, o.overrides); err != nil {
+		fail(exitUsage, "%v", err)
+	}
+	return e.build(width, height, t.palette(), config)
+}
+
+func runFlags(fs *flag.FlagSet) func(args []string) {
+	var o effectOptions
+	o.register(fs)
+	effect := fs.String("effect", "", "Effect to run, for the older 'syscgo -effect <name>' form")
+	duration := fs.Int("duration", 10, "Duration in seconds (0 = infinite)")
+	follow := fs.Bool("follow", false, "Animate lines appended to -file (or stdin) as they arrive (decrypt, pour, print)")
+	once := fs.Bool("once", false, "Play a text effect until complete, leave the final frame on screen and exit")
+	hold := fs.Duration("hold", 2*time.Second, "How long -once holds the final frame before exiting")
+	plain := fs.Bool("plain", false, "Print frames without cursor control, separated by -delimiter, e.g. to pipe them")
+	delimiter := fs.String("delimiter", "\f", "Line printed between frames with -plain")
+
+	return func(args []string) {
+		name := *effect
+		switch {
+		case len(args) > 1:
+			fail(exitUsage, "run takes a single effect, got %s", strings.Join(args, " "))
+		case len(args) == 1 && name != "" && args[0] != name:
+			fail(exitUsage, "effect given as both %s and -effect %s", args[0], name)
+		case len(args) == 1:
+			name = args[0]
+		case name == "":
+			name = "fire"
+		}
+
+		e := mustFindEffect(name)
+		t := o.resolveTheme()
+
+		// Plain output is meant for pipes, so any size will do
+		var width, height int
+		if *plain {
+			width, height, _ = o.size()
+		} else {
+			width, height = o.screenSize()
+		}
+
+		var text string
+		var input <-chan string
+		if *follow {
+			o.checkText(e)
+			if o.text != "" {
+				fail(exitUsage, "-follow reads from -file or stdin, not -text")
+			}
+			if o.file != "" && o.file != "-" {
+				var err error
+				text, input, err = followFile(o.file)
+				if err != nil {
+					fail(exitIO, "%v", err)
+				}
+			} else {
+				input = followStdin()
+			}
+		} else {
+			text = o.readText(e)
+		}
+
+		anim := o.build(e, t, width, height, text)
+		if *follow {
+			if _, ok := anim.(appender); !ok {
+				fail(exitUsage, "effect %s does not support -follow", e.name)
+			}
+		}
+		if *once {
+			if _, ok := anim.(completer); !ok || text == "" {
+				fail(exitUsage, "-once needs a text effect with text (decrypt, pour, print, beams, matrix)")
+			}
+			if *follow {
+				fail(exitUsage, "-once and -follow are mutually exclusive")
+			}
+		}
+
+		if !*plain {
+			restore := startScreen()
+			defer restore()
+		}
+
+		// Calculate frame count (0 = infinite, the default when following or
+		// playing once, where -duration only acts as an upper bound)
+		frames := 0
+		if *duration > 0 && (!*follow && !*once || flagWasSet(fs, "duration")) {
+			frames = *duration * 20 // 20 fps
+		}
+
+		last := play(anim, playOptions{
+			frames:    frames,
+			delay:     e.frameDelay,
+			input:     input,
+			wrapWidth: width - 10,
+			once:      *once,
+			plain:     *plain,
+			delimiter: *delimiter,
+		})
+
+		if *once && !*plain {
+			// Keep the final frame and leave the cursor on the line below the text
+			time.Sleep(*hold)
+			moveCursor(lastContentRow(last) + 1)
+		}
+	}
+}
@@ -0,0 +1,361 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// themeDef describes a built-in or user color theme
+type themeDef struct {
+	name        string
+	description string
+	base        string              // Built-in theme a user theme starts from
+	overrides   map[string][]string // Per-effect key=value parameters of a user theme
+	path        string              // File a user theme was loaded from
+}
+
+// themes lists every built-in theme, in the order shown by help and list
+var themes = []themeDef{
+	{name: "dracula", description: "Purple and pink vampiric vibes"},
+	{name: "gruvbox", description: "Retro warm colors"},
+	{name: "nord", description: "Cool arctic palette"},
+	{name: "tokyo-night", description: "Neon Tokyo nights"},
+	{name: "catppuccin", description: "Soothing pastel tones"},
+	{name: "material", description: "Google Material colors"},
+	{name: "solarized", description: "Classic precision colors"},
+	{name: "monochrome", description: "Grayscale aesthetic"},
+	{name: "transishardjob", description: "Trans pride colors"},
+}
+
+// userThemeExt is the extension of user theme files
+const userThemeExt = ".theme"
+
+// userThemeDir returns the directory user themes are loaded from,
+// $XDG_CONFIG_HOME/syscgo/themes or its platform equivalent
+func userThemeDir() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "syscgo", "themes")
+}
+
+// loadUserThemes reads every theme file in the user theme directory. Files
+// that can't be parsed are reported as warnings and skipped.
+//
+// A theme file is named <theme>.theme and holds key = value lines:
+//
+//	description = Deep sea blues
+//	base = nord
+//	pour.final-gradient-stops = #0b3954,#087e8b,#bfd7ea
+//	beams.final-gradient-stops = #087e8b,#bfd7ea
+//
+// base picks the built-in palettes used for everything not overridden, and
+// effect.parameter lines take the same values as -set.
+func loadUserThemes() []themeDef {
+	dir := userThemeDir()
+	if dir == "" {
+		return nil
+	}
+	paths, _ := filepath.Glob(filepath.Join(dir, "*"+userThemeExt))
+	sort.Strings(paths)
+
+	var loaded []themeDef
+	for _, path := range paths {
+		t, err := parseThemeFile(path)
+		if err != nil {
+			warn("skipping theme %s: %v", path, err)
+			continue
+		}
+		if _, ok := findBuiltinTheme(t.name); ok {
+			warn("skipping theme %s: %s is a built-in theme", path, t.name)
+			continue
+		}
+		loaded = append(loaded, t)
+	}
+	return loaded
+}
+
+// parseThemeFile reads a single user theme file
+func parseThemeFile(path string) (themeDef, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return themeDef{}, err
+	}
+	defer f.Close()
+
+	t := themeDef{
+		name:        strings.TrimSuffix(filepath.Base(path), userThemeExt),
+		description: "User theme",
+		base:        "dracula",
+		overrides:   map[string][]string{},
+		path:        path,
+	}
+
+	scanner := bufio.NewScanner(f)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return themeDef{}, fmt.Errorf("line %d: expected key = value", lineNum)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "description":
+			t.description = value
+		case "base":
+			if _, ok := findBuiltinTheme(value); !ok {
+				return themeDef{}, fmt.Errorf("line %d: unknown base theme %q", lineNum, value)
+			}
+			t.base = value
+		default:
+			effect, param, ok := strings.Cut(key, ".")
+			if !ok {
+				return themeDef{}, fmt.Errorf("line %d: unknown key %q", lineNum, key)
+			}
+			e, ok := findEffect(effect)
+			if !ok {
+				return themeDef{}, fmt.Errorf("line %d: unknown effect %q", lineNum, effect)
+			}
+			if e.config == nil {
+				return themeDef{}, fmt.Errorf("line %d: effect %s has no settable parameters", lineNum, effect)
+			}
+			t.overrides[effect] = append(t.overrides[effect], param+"="+value)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return themeDef{}, err
+	}
+	return t, nil
+}
+
+var userThemes []themeDef
+var userThemesLoaded bool
+
+// allThemes returns the built-in themes followed by the user themes
+func allThemes() []themeDef {
+	if !userThemesLoaded {
+		userThemes = loadUserThemes()
+		userThemesLoaded = true
+	}
+	return append(append([]themeDef{}, themes...), userThemes...)
+}
+
+// findBuiltinTheme looks up a theme shipped with syscgo
+func findBuiltinTheme(name string) (themeDef, bool) {
+	for _, t := range themes {
+		if t.name == name {
+			return t, true
+		}
+	}
+	return themeDef{}, false
+}
+
+// findTheme looks up a built-in or user theme by name
+func findTheme(name string) (themeDef, bool) {
+	for _, t := range allThemes() {
+		if t.name == name {
+			return t, true
+		}
+	}
+	return themeDef{}, false
+}
+
+// palette returns the built-in theme whose palettes the theme uses
+func (t themeDef) palette() string {
+	if t.base != "" {
+		return t.base
+	}
+	return t.name
+}
+
+// themeNames returns the names of all built-in and user themes
+func themeNames() []string {
+	all := allThemes()
+	names := make([]string, len(all))
+	for i, t := range all {
+		names[i] = t.name
+	}
+	return names
+}
+
+// resolveTheme looks up a theme, falling back to one that only carries the
+// name so the effects pick their default colors
+func resolveTheme(name string) themeDef {
+	if t, ok := findTheme(name); ok {
+		return t
+	}
+	return themeDef{name: name}
+}
+
+// effectConfig builds an effect config with the theme's palettes and
+// parameter overrides. It returns nil for effects without a config.
+func effectConfig(e effectDef, width, height int, t themeDef, text string) (any, error) {
+	if e.config == nil {
+		return nil, nil
+	}
+	config := e.config(width, height, e.frameDelay, t.palette(), text)
+	if err := applyOverrides(e.name, config, t.overrides[e.name]); err != nil {
+		return nil, fmt.Errorf("theme %s: %w", t.name, err)
+	}
+	return config, nil
+}
+
+func themesFlags(fs *flag.FlagSet) func(args []string) {
+	preview := fs.Bool("preview", false, "Play an effect for every theme side by side")
+	effect := fs.String("effect", "fire", "Effect played by -preview")
+	duration := fs.Int("duration", 10, "Seconds -preview plays for (0 = infinite)")
+
+	return func(args []string) {
+		switch {
+		case len(args) > 0 && args[0] == "show":
+			if len(args) != 2 || *preview {
+				fail(exitUsage, "usage: syscgo themes show <name>")
+			}
+			t, ok := findTheme(args[1])
+			if !ok {
+				fail(exitUnknownTheme, "%q (available: %s)", args[1], strings.Join(themeNames(), ", "))
+			}
+			showTheme(t)
+		case len(args) > 0:
+			fail(exitUsage, "unknown themes command %q (expected show)", args[0])
+		case *preview:
+			width, height, isTTY := terminalSize()
+			if !isTTY {
+				fail(exitNotTTY, "themes -preview needs a terminal")
+			}
+			previewThemes(mustFindEffect(*effect), width, height, *duration)
+		default:
+			listThemes()
+		}
+	}
+}
+
+// themeRole is a named set of colors a theme gives an effect
+type themeRole struct {
+	name   string
+	colors []string
+}
+
+// themeRoles returns every color role of a theme, per effect
+func themeRoles(e effectDef, t themeDef) []themeRole {
+	if e.config == nil {
+		return nil
+	}
+
+	config, err := effectConfig(e, 80, 24, t, e.defaultText)
+	if err != nil {
+		fail(exitUsage, "%v", err)
+	}
+	var roles []themeRole
+	for _, p := range effectParams(e.name, config) {
+		if !p.isColor() {
+			continue
+		}
+		switch v := p.value.Interface().(type) {
+		case string:
+			roles = append(roles, themeRole{p.name, []string{v}})
+		case []string:
+			roles = append(roles, themeRole{p.name, v})
+		}
+	}
+	return roles
+}
+
+// swatch renders a hex color as a block of that color
+func swatch(hex string) string {
+	var r, g, b int
+	if _, err := fmt.Sscanf(hex, "#%02x%02x%02x", &r, &g, &b); err != nil {
+		return "??"
+	}
+	return fmt.Sprintf("\033[38;2;%d;%d;%dm██\033[0m", r, g, b)
+}
+
+// showTheme prints a swatch of every color role of a theme
+func showTheme(t themeDef) {
+	fmt.Printf("%s - %s\n", t.name, t.description)
+	if t.path != "" {
+		fmt.Printf("Based on %s, loaded from %s\n", t.base, t.path)
+	}
+
+	for _, e := range effects {
+		roles := themeRoles(e, t)
+		if len(roles) == 0 {
+			continue
+		}
+		fmt.Printf("\n%s\n", e.name)
+
+		// Align by hand, tabwriter would count the color escapes as width
+		nameWidth, swatchWidth := 0, 0
+		for _, role := range roles {
+			nameWidth = max(nameWidth, len(role.name))
+			swatchWidth = max(swatchWidth, 2*len(role.colors))
+		}
+		for _, role := range roles {
+			var blocks strings.Builder
+			for _, color := range role.colors {
+				blocks.WriteString(swatch(color))
+			}
+			padding := strings.Repeat(" ", swatchWidth-2*len(role.colors))
+			fmt.Printf("  %-*s  %s%s  %s\n", nameWidth, role.name, blocks.String(), padding, strings.Join(role.colors, " "))
+		}
+	}
+}
+
+// previewThemes plays an effect for every theme at once, tiled in a grid
+// with the theme names above each tile
+func previewThemes(e effectDef, width, height, duration int) {
+	all := allThemes()
+	cols := int(math.Ceil(math.Sqrt(float64(len(all)))))
+	rows := (len(all) + cols - 1) / cols
+	tileWidth := width / cols
+	tileHeight := height/rows - 1 // One row for the label
+	if tileWidth < 10 || tileHeight < 3 {
+		fail(exitUsage, "terminal too small to preview %d themes", len(all))
+	}
+
+	var o effectOptions
+	anims := make([]animator, len(all))
+	for i, t := range all {
+		anims[i] = o.build(e, t, tileWidth-1, tileHeight, e.defaultText)
+	}
+
+	restore := startScreen()
+	defer restore()
+
+	frames := duration * 20 // 20 fps
+	for frame := 0; duration == 0 || frame < frames; frame++ {
+		var out strings.Builder
+		for i, anim := range anims {
+			anim.Update()
+			top := (i/cols)*(tileHeight+1) + 1
+			left := (i%cols)*tileWidth + 1
+
+			fmt.Fprintf(&out, "\033[%d;%dH\033[7m %-*s\033[0m", top, left, tileWidth-2, all[i].name)
+			for y, line := range strings.Split(anim.Render(), "\n") {
+				if y >= tileHeight {
+					break
+				}
+				// Pad short lines so nothing from the previous frame is left behind
+				visible := len([]rune(ansiPattern.ReplaceAllString(line, "")))
+				fmt.Fprintf(&out, "\033[%d;%dH%s\033[0m%s", top+1+y, left, line, strings.Repeat(" ", max(tileWidth-1-visible, 0)))
+			}
+		}
+		fmt.Print(out.String())
+		time.Sleep(e.frameDelay)
+	}
+	moveCursor(height - 1)
+}